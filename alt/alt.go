@@ -0,0 +1,126 @@
+package alt
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/sbiemont/grapo/dijkstra"
+)
+
+var (
+	ErrNoNodes = fmt.Errorf("no nodes to select landmarks")
+)
+
+// Selection is the strategy used to choose the landmarks
+type Selection int8
+
+const (
+	Farthest Selection = iota // each landmark is the node farthest from the previous ones
+	Avoid                     // each landmark is a leaf of the shortest path tree where the heuristic is the worst
+)
+
+// Landmarks stores the distances between each landmark and all nodes (ALT: A*, landmarks and triangle inequality)
+type Landmarks[T comparable] struct {
+	nodes []T
+	from  []map[T]float64 // distance from each landmark to the nodes
+	to    []map[T]float64 // distance from the nodes to each landmark
+}
+
+// New selects the landmarks and precomputes their distances using Dijkstra
+// * nodes:        all nodes of the graph
+// * count:        number of landmarks
+// * selection:    strategy used to choose the landmarks
+// * neighbors:    list of unordered neighbors of the given node with the distance (non negative)
+// * predecessors: list of nodes reaching the given node with the distance (can be nil for an undirected graph)
+func New[T comparable](nodes []T, count int, selection Selection, neighbors, predecessors func(T) map[T]float64) (*Landmarks[T], error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	if predecessors == nil {
+		predecessors = neighbors
+	}
+
+	l := &Landmarks[T]{}
+	for len(l.nodes) < min(count, len(nodes)) {
+		var landmark T
+		switch {
+		case len(l.nodes) == 0:
+			from, _ := dijkstra.ShortestPaths(nodes[0], nil, neighbors)
+			landmark = farthest(nodes, []map[T]float64{from})
+		case selection == Avoid:
+			landmark = l.avoid(nodes, neighbors)
+		default:
+			landmark = farthest(nodes, l.from)
+		}
+		from, _ := dijkstra.ShortestPaths(landmark, nil, neighbors)
+		to, _ := dijkstra.ShortestPaths(landmark, nil, predecessors)
+		l.nodes = append(l.nodes, landmark)
+		l.from = append(l.from, from)
+		l.to = append(l.to, to)
+	}
+	return l, nil
+}
+
+// Nodes returns the selected landmarks
+func (l *Landmarks[T]) Nodes() []T {
+	return l.nodes
+}
+
+// Distance is an admissible heuristic distance between 2 nodes, to use with astar
+// For each landmark L, the triangle inequality gives: d(a, b) >= d(L, b) - d(L, a) and d(a, b) >= d(a, L) - d(b, L)
+func (l *Landmarks[T]) Distance(a, b T) float64 {
+	var h float64
+	for i := range l.nodes {
+		if la, ok := l.from[i][a]; ok {
+			if lb, ok := l.from[i][b]; ok {
+				h = max(h, lb-la)
+			}
+		}
+		if al, ok := l.to[i][a]; ok {
+			if bl, ok := l.to[i][b]; ok {
+				h = max(h, al-bl)
+			}
+		}
+	}
+	return h
+}
+
+// table is the serialized form of the landmarks
+type table[T comparable] struct {
+	Nodes []T
+	From  []map[T]float64
+	To    []map[T]float64
+}
+
+// Save writes the landmarks and their distances (the nodes have to be encodable with encoding/gob)
+func (l *Landmarks[T]) Save(w io.Writer) error {
+	return gob.NewEncoder(w).Encode(table[T]{Nodes: l.nodes, From: l.from, To: l.to})
+}
+
+// Load reads landmarks written by Save
+func Load[T comparable](r io.Reader) (*Landmarks[T], error) {
+	var t table[T]
+	if err := gob.NewDecoder(r).Decode(&t); err != nil {
+		return nil, err
+	}
+	return &Landmarks[T]{nodes: t.Nodes, from: t.From, to: t.To}, nil
+}
+
+// farthest finds the node with the greatest distance to its closest landmark (unreachable nodes first)
+func farthest[T comparable](nodes []T, from []map[T]float64) T {
+	best, bestDist := nodes[0], -1.0
+	for _, n := range nodes {
+		closest := math.Inf(1)
+		for _, dist := range from {
+			if d, ok := dist[n]; ok {
+				closest = min(closest, d)
+			}
+		}
+		if closest > bestDist {
+			best, bestDist = n, closest
+		}
+	}
+	return best
+}
@@ -0,0 +1,128 @@
+package alt_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/alt"
+	"github.com/sbiemont/grapo/astar"
+	"github.com/sbiemont/grapo/dijkstra"
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestALT(t *testing.T) {
+	g, err := grid.Parse(`
+..........
+.########.
+.#......#.
+.#.####.#.
+.#.#..#.#.
+.#.#..#...
+.#.####.##
+.#......#.
+.##.#####.
+..........
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nodes []grid.Cell
+	for y := range g.Height() {
+		for x := range g.Width() {
+			if c := (grid.Cell{X: x, Y: y}); g.Walkable(c) {
+				nodes = append(nodes, c)
+			}
+		}
+	}
+	neighbors := func(c grid.Cell) map[grid.Cell]float64 {
+		res := make(map[grid.Cell]float64)
+		for _, n := range g.Neighbors(c) {
+			res[n] = g.MoveCost(c, n)
+		}
+		return res
+	}
+
+	for _, selection := range []alt.Selection{alt.Farthest, alt.Avoid} {
+		Convey("landmarks", t, func() {
+			landmarks, err := alt.New(nodes, 4, selection, neighbors, nil)
+			So(err, ShouldBeNil)
+			So(landmarks.Nodes(), ShouldHaveLength, 4)
+
+			Convey("when admissible", func() {
+				for _, a := range nodes {
+					dist, _ := dijkstra.ShortestPaths(a, nil, neighbors)
+					for _, b := range nodes {
+						if d, ok := dist[b]; ok {
+							So(landmarks.Distance(a, b), ShouldBeLessThanOrEqualTo, d+1e-9)
+						}
+					}
+				}
+			})
+
+			Convey("when used by astar", func() {
+				start, goal := grid.Cell{X: 0, Y: 0}, grid.Cell{X: 2, Y: 4}
+				path := astar.RunWithCost(start, goal, g.MoveCost, landmarks.Distance, g.Neighbors)
+				_, expected := dijkstra.RunNumeric(start, goal, nil, neighbors)
+				var cost float64
+				for i := 1; i < len(path); i++ {
+					cost += g.MoveCost(path[i-1], path[i])
+				}
+				So(cost, ShouldAlmostEqual, expected)
+				So(landmarks.Distance(start, goal), ShouldBeGreaterThan, 0)
+			})
+
+			Convey("when saved and loaded", func() {
+				var buf bytes.Buffer
+				So(landmarks.Save(&buf), ShouldBeNil)
+				loaded, err := alt.Load[grid.Cell](&buf)
+				So(err, ShouldBeNil)
+				So(loaded, ShouldResemble, landmarks)
+			})
+		})
+	}
+
+	Convey("when directed graph", t, func() {
+		// a -1-> b -1-> c -1-> a
+		next := map[string]string{"a": "b", "b": "c", "c": "a"}
+		neighbors := func(n string) map[string]float64 { return map[string]float64{next[n]: 1} }
+		predecessors := func(n string) map[string]float64 {
+			for k, v := range next {
+				if v == n {
+					return map[string]float64{k: 1}
+				}
+			}
+			return nil
+		}
+		landmarks, err := alt.New([]string{"a", "b", "c"}, 3, alt.Farthest, neighbors, predecessors)
+		So(err, ShouldBeNil)
+		So(landmarks.Distance("a", "c"), ShouldEqual, 2)
+		So(landmarks.Distance("c", "a"), ShouldEqual, 1)
+	})
+
+	Convey("when errors", t, func() {
+		_, err := alt.New(nil, 4, alt.Farthest, neighbors, nil)
+		So(err, ShouldBeError, alt.ErrNoNodes.Error())
+
+		_, err = alt.Load[grid.Cell](bytes.NewBufferString("invalid"))
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("when unreachable nodes", t, func() {
+		island, err := grid.Parse("..#..")
+		So(err, ShouldBeNil)
+		landmarks, err := alt.New([]grid.Cell{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 3, Y: 0}, {X: 4, Y: 0}}, 2, alt.Farthest,
+			func(c grid.Cell) map[grid.Cell]float64 {
+				res := make(map[grid.Cell]float64)
+				for _, n := range island.Neighbors(c) {
+					res[n] = 1
+				}
+				return res
+			}, nil)
+		So(err, ShouldBeNil)
+		So(landmarks.Distance(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 4, Y: 0}), ShouldEqual, 0)
+		So(math.IsInf(landmarks.Distance(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 1, Y: 0}), 0), ShouldBeFalse)
+	})
+}
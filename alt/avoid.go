@@ -0,0 +1,63 @@
+package alt
+
+import (
+	"github.com/sbiemont/grapo/dijkstra"
+)
+
+// avoid selects a new landmark where the current heuristic is the worst
+// The shortest path tree of a root is weighted by the error of the heuristic from the root,
+// the landmark is the leaf reached by following the heaviest subtrees without landmarks
+func (l *Landmarks[T]) avoid(nodes []T, neighbors func(T) map[T]float64) T {
+	root := farthest(nodes, l.from)
+	dist, prev := dijkstra.ShortestPaths(root, nil, neighbors)
+
+	// Children of each node in the shortest path tree
+	children := make(map[T][]T)
+	for _, n := range nodes {
+		if p, ok := prev[n]; ok {
+			children[p] = append(children[p], n)
+		}
+	}
+	landmarks := make(map[T]bool, len(l.nodes))
+	for _, n := range l.nodes {
+		landmarks[n] = true
+	}
+
+	// Size of each subtree: sum of the heuristic errors, or 0 if it contains a landmark
+	size := make(map[T]float64)
+	var compute func(n T) (float64, bool)
+	compute = func(n T) (float64, bool) {
+		total := dist[n] - l.Distance(root, n)
+		covered := landmarks[n]
+		for _, c := range children[n] {
+			s, ok := compute(c)
+			total += s
+			covered = covered || ok
+		}
+		if covered {
+			total = 0
+		}
+		size[n] = total
+		return total, covered
+	}
+	compute(root)
+
+	// Follow the heaviest subtrees down to a leaf
+	current := root
+	for {
+		next, best := current, 0.0
+		for _, c := range children[current] {
+			if size[c] > best {
+				next, best = c, size[c]
+			}
+		}
+		if next == current {
+			break
+		}
+		current = next
+	}
+	if landmarks[current] {
+		return farthest(nodes, l.from) // all subtrees are covered
+	}
+	return current
+}
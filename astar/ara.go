@@ -0,0 +1,182 @@
+package astar
+
+import (
+	"container/heap"
+	"math"
+	"time"
+)
+
+// ARA is the anytime repairing A* search (ARA*)
+// A first path is quickly found using a weighted A*, then the weight decreases to improve the path
+// Each improvement reuses the previous search
+type ARA[T comparable] struct {
+	goal      T
+	epsilon   float64 // current weight of the heuristic distance
+	decrease  float64 // decrease of epsilon at each improvement
+	bound     float64 // suboptimality bound of the last path
+	started   bool    // true when the first path has been searched
+	cost      func(T, T) float64
+	distance  func(T, T) float64
+	neighbors func(T) []T
+
+	c          *converter[T, float64]
+	startNode  *node[T, float64]
+	goalNode   *node[T, float64]
+	queue      *priorityQueue[T, float64]
+	openedList map[*node[T, float64]]struct{}
+	closedList map[*node[T, float64]]struct{}
+	inconsList map[*node[T, float64]]struct{} // nodes improved while already closed
+}
+
+// NewARA prepares an anytime repairing A* search
+// * start:     first node of the path
+// * goal:      last node of the path
+// * epsilon:   initial weight of the heuristic distance (> 1)
+// * decrease:  decrease of epsilon at each improvement (if <= 0, epsilon drops to 1 after the first path)
+// * cost:      give the cost of a move from a node to one of its neighbors (can be nil to give all moves a 0 cost)
+// * distance:  heuristic (estimated) distance between 2 nodes (has to be admissible)
+// * neighbors: list of unordered neighbors of the given node
+func NewARA[T comparable](start, goal T, epsilon, decrease float64, cost func(T, T) float64, distance func(T, T) float64, neighbors func(T) []T) *ARA[T] {
+	a := &ARA[T]{
+		goal:       goal,
+		epsilon:    max(epsilon, 1),
+		decrease:   decrease,
+		bound:      math.Inf(1),
+		cost:       cost,
+		distance:   distance,
+		neighbors:  neighbors,
+		c:          newConverter[T, float64](),
+		queue:      &priorityQueue[T, float64]{},
+		openedList: map[*node[T, float64]]struct{}{},
+		closedList: map[*node[T, float64]]struct{}{},
+		inconsList: map[*node[T, float64]]struct{}{},
+	}
+	a.startNode = a.fetch(start)
+	a.goalNode = a.fetch(goal)
+	a.startNode.g = 0
+	a.push(a.startNode)
+	return a
+}
+
+// Next searches a better path
+// Returns the path, its suboptimality bound (the path cost is at most bound times the optimal cost)
+// and false if no more improvement can be done (the last path is optimal or no path exists)
+func (a *ARA[T]) Next() ([]T, float64, bool) {
+	if a.started {
+		if a.bound <= 1 {
+			return nil, a.bound, false
+		}
+
+		// Decrease epsilon and reuse the inconsistent nodes
+		switch {
+		case a.decrease > 0:
+			a.epsilon = max(a.epsilon-a.decrease, 1)
+		default:
+			a.epsilon = 1 // without a decrease, the path would never improve
+		}
+		for n := range a.inconsList {
+			a.openedList[n] = struct{}{}
+			*a.queue = append(*a.queue, n)
+		}
+		clear(a.inconsList)
+		clear(a.closedList)
+		for i, n := range *a.queue {
+			n.f = a.f(n)
+			n.index = i
+		}
+		heap.Init(a.queue)
+	}
+	a.started = true
+
+	a.improve()
+	if math.IsInf(a.goalNode.g, 1) {
+		a.bound = 1
+		return nil, a.bound, false // no path
+	}
+
+	// Compute the suboptimality bound
+	lowest := math.Inf(1)
+	for _, list := range []map[*node[T, float64]]struct{}{a.openedList, a.inconsList} {
+		for n := range list {
+			lowest = min(lowest, n.g+n.h)
+		}
+	}
+	a.bound = min(a.epsilon, a.goalNode.g/lowest)
+	if math.IsInf(lowest, 1) || math.IsNaN(a.bound) {
+		a.bound = 1
+	}
+	return path(a.startNode, a.goalNode), a.bound, true
+}
+
+// Run improves the path until the time budget is elapsed or the path is optimal
+// The budget is checked between 2 improvements
+// Returns the best path found and its suboptimality bound
+func (a *ARA[T]) Run(budget time.Duration) ([]T, float64) {
+	deadline := time.Now().Add(budget)
+	var best []T
+	for {
+		p, bound, ok := a.Next()
+		if p != nil {
+			best = p
+		}
+		if !ok || time.Now().After(deadline) {
+			return best, bound
+		}
+	}
+}
+
+// improve expands nodes until the goal cannot be reached with a lower f
+func (a *ARA[T]) improve() {
+	for a.queue.Len() > 0 && a.f(a.goalNode) > (*a.queue)[0].f {
+		currentNode := heap.Pop(a.queue).(*node[T, float64])
+		delete(a.openedList, currentNode)
+		a.closedList[currentNode] = struct{}{}
+
+		for _, neighbor := range a.neighbors(currentNode.value) {
+			neighborNode := a.fetch(neighbor)
+			gEstimated := currentNode.g
+			if a.cost != nil {
+				gEstimated += a.cost(currentNode.value, neighbor)
+			}
+			if gEstimated >= neighborNode.g {
+				continue // bad path, next node
+			}
+
+			// Best current path
+			neighborNode.parent = currentNode
+			neighborNode.g = gEstimated
+			switch _, closed := a.closedList[neighborNode]; {
+			case closed:
+				a.inconsList[neighborNode] = struct{}{}
+			default:
+				if _, opened := a.openedList[neighborNode]; opened {
+					heap.Remove(a.queue, neighborNode.index)
+				}
+				a.push(neighborNode)
+			}
+		}
+	}
+}
+
+// fetch retrieves the node, a new node has an infinite cost
+func (a *ARA[T]) fetch(v T) *node[T, float64] {
+	if n, ok := a.c.cache[v]; ok {
+		return n
+	}
+	n := a.c.fetch(v)
+	n.g = math.Inf(1)
+	n.h = a.distance(v, a.goal)
+	return n
+}
+
+// push adds the node in the opened list
+func (a *ARA[T]) push(n *node[T, float64]) {
+	n.f = a.f(n)
+	a.openedList[n] = struct{}{}
+	heap.Push(a.queue, n)
+}
+
+// f is the estimated cost of the node using the current epsilon
+func (a *ARA[T]) f(n *node[T, float64]) float64 {
+	return n.g + a.epsilon*n.h
+}
@@ -0,0 +1,109 @@
+package astar_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sbiemont/grapo/astar"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWeightedAStar(t *testing.T) {
+	// node is a 2D point with coordinates and neighbors
+	type node struct {
+		id        string
+		x, y      float64
+		neighbors map[*node]float64
+	}
+
+	// s -> a -> g: a is close to the goal, but a -> g is expensive (cost 15)
+	// s -> b -> g: optimal path (cost 10√2)
+	nodeS := &node{id: "s", x: 0, y: 0}
+	nodeA := &node{id: "a", x: 5, y: 0}
+	nodeB := &node{id: "b", x: 5, y: 5}
+	nodeG := &node{id: "g", x: 10, y: 0}
+	nodeS.neighbors = map[*node]float64{nodeA: 5, nodeB: 5 * math.Sqrt2}
+	nodeA.neighbors = map[*node]float64{nodeG: 10}
+	nodeB.neighbors = map[*node]float64{nodeG: 5 * math.Sqrt2}
+
+	cost := func(a, b *node) float64 { return a.neighbors[b] }
+	distance := func(a, b *node) float64 { return astar.EuclideanDistance(a.x, a.y, b.x, b.y) }
+	neighbors := func(a *node) []*node {
+		var result []*node
+		for n := range a.neighbors {
+			result = append(result, n)
+		}
+		return result
+	}
+
+	Convey("weighted A*", t, func() {
+		Convey("when epsilon is 1", func() {
+			path := astar.RunWeighted(nodeS, nodeG, 1, cost, distance, neighbors)
+			So(path, ShouldResemble, []*node{nodeS, nodeB, nodeG})
+		})
+
+		Convey("when epsilon is high", func() {
+			path := astar.RunWeighted(nodeS, nodeG, 5, cost, distance, neighbors)
+			So(path, ShouldResemble, []*node{nodeS, nodeA, nodeG})
+		})
+	})
+
+	Convey("ARA*", t, func() {
+		Convey("when polled", func() {
+			ara := astar.NewARA(nodeS, nodeG, 5, 2, cost, distance, neighbors)
+
+			// First path: fast and suboptimal
+			path, bound, ok := ara.Next()
+			So(ok, ShouldBeTrue)
+			So(path, ShouldResemble, []*node{nodeS, nodeA, nodeG})
+			So(bound, ShouldAlmostEqual, 15/(10*math.Sqrt2))
+
+			// Improve until the optimal path
+			var last []*node
+			for ok {
+				last = path
+				path, bound, ok = ara.Next()
+			}
+			So(last, ShouldResemble, []*node{nodeS, nodeB, nodeG})
+			So(bound, ShouldEqual, 1)
+		})
+
+		Convey("when time budget", func() {
+			ara := astar.NewARA(nodeS, nodeG, 5, 1, cost, distance, neighbors)
+			path, bound := ara.Run(time.Second)
+			So(path, ShouldResemble, []*node{nodeS, nodeB, nodeG})
+			So(bound, ShouldEqual, 1)
+		})
+
+		Convey("when no decrease", func() {
+			ara := astar.NewARA(nodeS, nodeG, 5, 0, cost, distance, neighbors)
+			path, bound := ara.Run(time.Second)
+			So(path, ShouldResemble, []*node{nodeS, nodeB, nodeG})
+			So(bound, ShouldEqual, 1)
+
+			ara = astar.NewARA(nodeS, nodeG, 5, -1, cost, distance, neighbors)
+			_, _, ok := ara.Next()
+			So(ok, ShouldBeTrue)
+			path, bound, ok = ara.Next()
+			So(ok, ShouldBeTrue)
+			So(path, ShouldResemble, []*node{nodeS, nodeB, nodeG})
+			So(bound, ShouldEqual, 1)
+		})
+
+		Convey("when same start and goal", func() {
+			ara := astar.NewARA(nodeS, nodeS, 5, 1, cost, distance, neighbors)
+			path, bound, _ := ara.Next()
+			So(path, ShouldResemble, []*node{nodeS})
+			So(bound, ShouldEqual, 1)
+		})
+
+		Convey("when no path", func() {
+			ara := astar.NewARA(nodeG, nodeS, 5, 1, cost, distance, neighbors)
+			path, _, ok := ara.Next()
+			So(ok, ShouldBeFalse)
+			So(path, ShouldBeNil)
+		})
+	})
+}
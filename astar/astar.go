@@ -2,56 +2,41 @@ package astar
 
 import (
 	"container/heap"
-	"math"
 	"slices"
-)
-
-// ManhattanDistance is the sum of the absolute differences of their coordinates
-// |x1 - x2| + |y1 - y2|
-// Helper function
-func ManhattanDistance(x1, y1, x2, y2 float64) float64 {
-	return math.Abs(x1-x2) + math.Abs(y1-y2)
-}
 
-// EuclideanDistance calculates the straight-line distance between two points in a 2D space
-// √((x2 - x1)² + (y2 - y1)²)
-// Helper function
-func EuclideanDistance(x1, y1, x2, y2 float64) float64 {
-	x := float64(x2 - x1)
-	y := float64(y2 - y1)
-	return math.Sqrt(x*x + y*y)
-}
+	"github.com/sbiemont/grapo/dijkstra"
+)
 
 // node is an internal struct to store data
-type node[T comparable] struct {
-	value  T        // actual value of the node
-	f      float64  // total estimated cost (f=g+h)
-	g      float64  // cost from current node
-	h      float64  // heuristic (estimated) distance from node to goal
-	index  int      // for priority queue
-	parent *node[T] // parent computed during A* search
+type node[T comparable, C dijkstra.Number] struct {
+	value  T           // actual value of the node
+	f      C           // total estimated cost (f=g+h)
+	g      C           // cost from current node
+	h      C           // heuristic (estimated) distance from node to goal
+	index  int         // for priority queue
+	parent *node[T, C] // parent computed during A* search
 }
 
 // converter is a map to store nodes and their corresponding values
 // it uses two maps to allow bidirectional lookup
-type converter[T comparable] struct {
-	cache map[T]*node[T]
+type converter[T comparable, C dijkstra.Number] struct {
+	cache map[T]*node[T, C]
 }
 
 // newConverter creates a new empty cache for the given type
-func newConverter[T comparable]() *converter[T] {
-	return &converter[T]{
-		cache: make(map[T]*node[T]),
+func newConverter[T comparable, C dijkstra.Number]() *converter[T, C] {
+	return &converter[T, C]{
+		cache: make(map[T]*node[T, C]),
 	}
 }
 
 // fetch retrieves the node from the cache or creates a new one if it doesn't exist
-func (c converter[T]) fetch(n T) *node[T] {
+func (c converter[T, C]) fetch(n T) *node[T, C] {
 	m, ok := c.cache[n]
 	if ok {
 		return m
 	}
-	m = &node[T]{value: n}
+	m = &node[T, C]{value: n}
 	c.cache[n] = m
 	return m
 }
@@ -64,20 +49,76 @@ func (c converter[T]) fetch(n T) *node[T] {
 // * neighbors: list of unordered neighbors of the given node
 // Returns the found path or nil if nothing is found
 func Run[T comparable](start, goal T, weight func(T) float64, distance func(T, T) float64, neighbors func(T) []T) []T {
+	var cost func(T, T) float64
+	if weight != nil {
+		cost = func(from, _ T) float64 { return weight(from) }
+	}
+	return RunWithCost(start, goal, cost, distance, neighbors)
+}
+
+// RunWithCost performs the A* search algorithm using the cost of each move
+// * start:     first node of the path
+// * goal:      last node of the path
+// * cost:      give the cost of a move from a node to one of its neighbors (can be nil to give all moves a 0 cost)
+// * distance:  heuristic (estimated) distance between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the found path or nil if nothing is found
+func RunWithCost[T comparable](start, goal T, cost func(T, T) float64, distance func(T, T) float64, neighbors func(T) []T) []T {
+	return RunWeighted(start, goal, 1, cost, distance, neighbors)
+}
+
+// RunWeighted performs the weighted A* search algorithm (f = g + epsilon*h)
+// A greater epsilon explores less nodes, the path cost is at most epsilon times the optimal cost
+// (the heuristic distance has to be admissible)
+// * start:     first node of the path
+// * goal:      last node of the path
+// * epsilon:   weight of the heuristic distance (>= 1, 1 for a standard A*)
+// * cost:      give the cost of a move from a node to one of its neighbors (can be nil to give all moves a 0 cost)
+// * distance:  heuristic (estimated) distance between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the found path or nil if nothing is found
+func RunWeighted[T comparable](start, goal T, epsilon float64, cost func(T, T) float64, distance func(T, T) float64, neighbors func(T) []T) []T {
+	f := func(g, h float64) float64 { return g + epsilon*h }
+	return search(start, goal, cost, distance, neighbors, f)
+}
+
+// RunNumeric performs the A* search algorithm with any numeric type of costs
+// Integer costs avoid rounding problems (the float64 functions use the same search)
+// * start:     first node of the path
+// * goal:      last node of the path
+// * cost:      give the cost of a move from a node to one of its neighbors (can be nil to give all moves a 0 cost)
+// * distance:  heuristic (estimated) distance between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the found path and its cost, or nil if nothing is found
+func RunNumeric[T comparable, C dijkstra.Number](start, goal T, cost func(T, T) C, distance func(T, T) C, neighbors func(T) []T) ([]T, C) {
+	f := func(g, h C) C { return g + h }
+	p := search(start, goal, cost, distance, neighbors, f)
+	if p == nil {
+		return nil, 0
+	}
+	var total C
+	for i := 1; cost != nil && i < len(p); i++ {
+		total += cost(p[i-1], p[i])
+	}
+	return p, total
+}
+
+// search performs the A* search algorithm, ordering the nodes by f(g, h)
+func search[T comparable, C dijkstra.Number](start, goal T, cost func(T, T) C, distance func(T, T) C, neighbors func(T) []T, f func(g, h C) C) []T {
 	// Initialize opened and closed lists
-	c := newConverter[T]()
+	c := newConverter[T, C]()
 	startNode := c.fetch(start)
-	openedList := map[*node[T]]struct{}{startNode: {}}
-	closedList := map[*node[T]]struct{}{}
-	queue := &priorityQueue[T]{}
+	openedList := map[*node[T, C]]struct{}{startNode: {}}
+	closedList := map[*node[T, C]]struct{}{}
+	queue := &priorityQueue[T, C]{}
 	heap.Init(queue)
 	heap.Push(queue, startNode)
 
 	// Initialize node properties
-	startNode.g = 0                         // Cost from start to start is 0
-	startNode.h = distance(start, goal)     // Estimate to goal
-	startNode.f = startNode.g + startNode.h // Total estimated cost
-	startNode.parent = nil                  // For path reconstruction
+	startNode.g = 0                           // Cost from start to start is 0
+	startNode.h = distance(start, goal)       // Estimate to goal
+	startNode.f = f(startNode.g, startNode.h) // Total estimated cost
+	startNode.parent = nil                    // For path reconstruction
 
 	goalNode := c.fetch(goal)
 
@@ -88,7 +129,7 @@ func Run[T comparable](start, goal T, weight func(T) float64, distance func(T, T
 		}
 
 		// Get node with lowest f value (from prority queue)
-		currentNode := heap.Pop(queue).(*node[T])
+		currentNode := heap.Pop(queue).(*node[T, C])
 		current := currentNode.value
 
 		// Check if we've reached the goal
@@ -108,8 +149,8 @@ func Run[T comparable](start, goal T, weight func(T) float64, distance func(T, T
 
 			// Estimage g
 			gEstimated := currentNode.g
-			if weight != nil {
-				gEstimated += weight(current)
+			if cost != nil {
+				gEstimated += cost(current, neighbor)
 			}
 			_, opened := openedList[neighborNode]
 			switch {
@@ -125,7 +166,7 @@ func Run[T comparable](start, goal T, weight func(T) float64, distance func(T, T
 			neighborNode.parent = currentNode
 			neighborNode.g = gEstimated
 			neighborNode.h = distance(neighbor, goal)
-			neighborNode.f = neighborNode.g + neighborNode.h
+			neighborNode.f = f(neighborNode.g, neighborNode.h)
 			heap.Push(queue, neighborNode)
 		}
 	}
@@ -133,9 +174,9 @@ func Run[T comparable](start, goal T, weight func(T) float64, distance func(T, T
 }
 
 // path from start to current node
-func path[T comparable](start, current *node[T]) []T {
+func path[T comparable, C dijkstra.Number](start, current *node[T, C]) []T {
 	// run from current to start node using parents and inverse the path
-	var path []*node[T]
+	var path []*node[T, C]
 	for current != start {
 		path = append(path, current)
 		current = current.parent
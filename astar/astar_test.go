@@ -47,7 +47,7 @@ func TestAStarOnMatrix(t *testing.T) {
 
 	// distance between 2 nodes in the matrix
 	distance := func(a, b node) float64 {
-		return astar.EuclideanDistance(float64(a.i), float64(a.j), float64(b.j), float64(b.j))
+		return astar.EuclideanDistance(float64(a.i), float64(a.j), float64(b.i), float64(b.j))
 	}
 
 	// neighbors of node in the matrix
@@ -223,3 +223,70 @@ func TestAStarOnNodeWithCoordinates(t *testing.T) {
 		So(path, ShouldResemble, []*node{nodeA, nodeB, nodeF, nodeI, nodeG})
 	})
 }
+
+func TestAStarWithCost(t *testing.T) {
+	// a -> b -> d: cheap moves
+	// a -> c -> d: expensive move from a to c
+	neighbors := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"d"},
+	}
+	costs := map[[2]string]float64{
+		{"a", "b"}: 2, {"b", "d"}: 2,
+		{"a", "c"}: 5, {"c", "d"}: 1,
+	}
+	cost := func(from, to string) float64 { return costs[[2]string{from, to}] }
+	distance := func(a, b string) float64 { return 0 }
+
+	Convey("when ok", t, func() {
+		path := astar.RunWithCost("a", "d", cost, distance, func(n string) []string { return neighbors[n] })
+		So(path, ShouldResemble, []string{"a", "b", "d"})
+	})
+
+	Convey("when no path", t, func() {
+		path := astar.RunWithCost("d", "a", cost, distance, func(n string) []string { return neighbors[n] })
+		So(path, ShouldBeNil)
+	})
+}
+
+func TestAStarNumeric(t *testing.T) {
+	type cell struct{ x, y int }
+
+	// . . . .
+	// . # # .
+	// . . . .
+	blocked := map[cell]bool{{1, 1}: true, {2, 1}: true}
+	neighbors := func(c cell) []cell {
+		var res []cell
+		for _, d := range []cell{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+			n := cell{c.x + d.x, c.y + d.y}
+			if n.x >= 0 && n.x < 4 && n.y >= 0 && n.y < 3 && !blocked[n] {
+				res = append(res, n)
+			}
+		}
+		return res
+	}
+	manhattan := func(a, b cell) int { return max(a.x-b.x, b.x-a.x) + max(a.y-b.y, b.y-a.y) }
+
+	Convey("when integer costs", t, func() {
+		// the lower row costs more
+		cost := func(_, to cell) int {
+			if to.y == 2 {
+				return 3
+			}
+			return 1
+		}
+		path, total := astar.RunNumeric(cell{0, 1}, cell{3, 1}, cost, manhattan, neighbors)
+		So(path, ShouldResemble, []cell{{0, 1}, {0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}})
+		So(total, ShouldEqual, 5)
+	})
+
+	Convey("when no path", t, func() {
+		blocked[cell{0, 0}] = true
+		blocked[cell{0, 2}] = true
+		path, total := astar.RunNumeric(cell{0, 1}, cell{3, 1}, nil, manhattan, neighbors)
+		So(path, ShouldBeNil)
+		So(total, ShouldEqual, 0)
+	})
+}
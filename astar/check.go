@@ -0,0 +1,83 @@
+package astar
+
+import (
+	"github.com/sbiemont/grapo/dijkstra"
+)
+
+// tolerance for the rounding errors when comparing the heuristic distance
+const tolerance = 1e-9
+
+// Violation is a pair of nodes where the heuristic distance is too high
+type Violation[T comparable] struct {
+	From      T       // node where the heuristic distance is computed
+	To        T       // other node of the pair (the goal for admissibility, a neighbor for consistency)
+	Goal      T       // goal of the heuristic distance
+	Heuristic float64 // heuristic distance from From to Goal
+	Limit     float64 // true distance from From to Goal (admissibility), or cost From -> To plus heuristic To -> Goal (consistency)
+}
+
+// CheckAdmissible checks that the heuristic distance never overestimates the true distance (debug utility)
+// True distances are computed with Dijkstra from each node, on the whole reachable graph
+// * nodes:     all nodes of a finite graph, or a sample of nodes
+// * cost:      give the cost of a move from a node to one of its neighbors (can be nil to give all moves a 0 cost)
+// * distance:  heuristic (estimated) distance between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the pairs of nodes where the heuristic distance is greater than the true distance
+func CheckAdmissible[T comparable](nodes []T, cost func(T, T) float64, distance func(T, T) float64, neighbors func(T) []T) []Violation[T] {
+	var violations []Violation[T]
+	for _, from := range nodes {
+		dist, _ := dijkstra.ShortestPaths(from, nil, costs(cost, neighbors))
+		for _, goal := range nodes {
+			d, ok := dist[goal]
+			if !ok {
+				continue // not reachable: any heuristic distance is admissible
+			}
+			if h := distance(from, goal); h > d+tolerance {
+				violations = append(violations, Violation[T]{From: from, To: goal, Goal: goal, Heuristic: h, Limit: d})
+			}
+		}
+	}
+	return violations
+}
+
+// CheckConsistent checks that the heuristic distance never decreases more than the cost of a move (debug utility)
+// A consistent heuristic distance is admissible, and A* never reopens a closed node
+// * nodes:     all nodes of a finite graph, or a sample of nodes (used as starts of the moves and as goals)
+// * cost:      give the cost of a move from a node to one of its neighbors (can be nil to give all moves a 0 cost)
+// * distance:  heuristic (estimated) distance between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the moves where the heuristic distance of the node is greater than the cost of the move plus the heuristic distance of the neighbor
+func CheckConsistent[T comparable](nodes []T, cost func(T, T) float64, distance func(T, T) float64, neighbors func(T) []T) []Violation[T] {
+	var violations []Violation[T]
+	for _, from := range nodes {
+		moves := neighbors(from)
+		for _, goal := range nodes {
+			h := distance(from, goal)
+			for _, to := range moves {
+				var c float64
+				if cost != nil {
+					c = cost(from, to)
+				}
+				if limit := c + distance(to, goal); h > limit+tolerance {
+					violations = append(violations, Violation[T]{From: from, To: to, Goal: goal, Heuristic: h, Limit: limit})
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// costs builds the neighbors of a node with the cost of the moves (for dijkstra)
+func costs[T comparable](cost func(T, T) float64, neighbors func(T) []T) func(T) map[T]float64 {
+	return func(n T) map[T]float64 {
+		res := make(map[T]float64)
+		for _, m := range neighbors(n) {
+			var c float64
+			if cost != nil {
+				c = cost(n, m)
+			}
+			res[m] = c
+		}
+		return res
+	}
+}
@@ -0,0 +1,81 @@
+package astar_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/astar"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCheck(t *testing.T) {
+	type cell struct{ x, y int }
+
+	// 3x3 grid, 4 directions, moves cost 1
+	var nodes []cell
+	for y := range 3 {
+		for x := range 3 {
+			nodes = append(nodes, cell{x, y})
+		}
+	}
+	neighbors := func(c cell) []cell {
+		var res []cell
+		for _, d := range []cell{{0, -1}, {0, 1}, {-1, 0}, {1, 0}} {
+			if n := (cell{c.x + d.x, c.y + d.y}); n.x >= 0 && n.x < 3 && n.y >= 0 && n.y < 3 {
+				res = append(res, n)
+			}
+		}
+		return res
+	}
+	cost := func(_, _ cell) float64 { return 1 }
+	coords := func(c cell) (float64, float64) { return float64(c.x), float64(c.y) }
+
+	Convey("when valid heuristic", t, func() {
+		for _, dist := range []func(x1, y1, x2, y2 float64) float64{astar.ManhattanDistance, astar.EuclideanDistance, astar.ChebyshevDistance} {
+			distance := astar.Distance2D(coords, dist)
+			So(astar.CheckAdmissible(nodes, cost, distance, neighbors), ShouldBeEmpty)
+			So(astar.CheckConsistent(nodes, cost, distance, neighbors), ShouldBeEmpty)
+		}
+	})
+
+	Convey("when overestimating heuristic", t, func() {
+		// twice the manhattan distance
+		double := func(a, b cell) float64 {
+			return 2 * astar.ManhattanDistance(float64(a.x), float64(a.y), float64(b.x), float64(b.y))
+		}
+		violations := astar.CheckAdmissible([]cell{{0, 0}, {1, 0}}, cost, double, neighbors)
+		So(violations, ShouldResemble, []astar.Violation[cell]{
+			{From: cell{0, 0}, To: cell{1, 0}, Goal: cell{1, 0}, Heuristic: 2, Limit: 1},
+			{From: cell{1, 0}, To: cell{0, 0}, Goal: cell{0, 0}, Heuristic: 2, Limit: 1},
+		})
+
+		violations = astar.CheckConsistent([]cell{{0, 0}, {1, 0}}, cost, double, neighbors)
+		So(violations, ShouldResemble, []astar.Violation[cell]{
+			{From: cell{0, 0}, To: cell{1, 0}, Goal: cell{1, 0}, Heuristic: 2, Limit: 1},
+			{From: cell{1, 0}, To: cell{0, 0}, Goal: cell{0, 0}, Heuristic: 2, Limit: 1},
+		})
+	})
+
+	Convey("when admissible but not consistent", t, func() {
+		// the heuristic distance drops by 2 between (0,0) and (1,0)
+		inconsistent := func(a, b cell) float64 {
+			if b == (cell{2, 0}) && a == (cell{0, 0}) {
+				return 2
+			}
+			return 0
+		}
+		So(astar.CheckAdmissible(nodes, cost, inconsistent, neighbors), ShouldBeEmpty)
+		So(astar.CheckConsistent(nodes, cost, inconsistent, neighbors), ShouldResemble, []astar.Violation[cell]{
+			{From: cell{0, 0}, To: cell{0, 1}, Goal: cell{2, 0}, Heuristic: 2, Limit: 1},
+			{From: cell{0, 0}, To: cell{1, 0}, Goal: cell{2, 0}, Heuristic: 2, Limit: 1},
+		})
+	})
+
+	Convey("when the astar matrix heuristic is checked", t, func() {
+		// b.y used twice: from (0,0) to (0,2), the heuristic distance is 2.83 but the true distance is 2
+		buggy := func(a, b cell) float64 {
+			return astar.EuclideanDistance(float64(a.x), float64(a.y), float64(b.y), float64(b.y))
+		}
+		So(astar.CheckAdmissible(nodes, cost, buggy, neighbors), ShouldNotBeEmpty)
+	})
+}
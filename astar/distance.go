@@ -0,0 +1,105 @@
+package astar
+
+import "math"
+
+// ManhattanDistance is the sum of the absolute differences of their coordinates
+// |x1 - x2| + |y1 - y2|
+// Helper function
+func ManhattanDistance(x1, y1, x2, y2 float64) float64 {
+	return math.Abs(x1-x2) + math.Abs(y1-y2)
+}
+
+// EuclideanDistance calculates the straight-line distance between two points in a 2D space
+// √((x2 - x1)² + (y2 - y1)²)
+// Helper function
+func EuclideanDistance(x1, y1, x2, y2 float64) float64 {
+	x := float64(x2 - x1)
+	y := float64(y2 - y1)
+	return math.Sqrt(x*x + y*y)
+}
+
+// ChebyshevDistance is the greatest of the absolute differences of their coordinates
+// (8 directions moves, diagonal moves cost 1)
+// max(|x1 - x2|, |y1 - y2|)
+// Helper function
+func ChebyshevDistance(x1, y1, x2, y2 float64) float64 {
+	return max(math.Abs(x1-x2), math.Abs(y1-y2))
+}
+
+// OctileDistance is the length of the shortest path on an open grid
+// (8 directions moves, diagonal moves cost √2)
+// max(dx, dy) + (√2 - 1) * min(dx, dy)
+// Helper function
+func OctileDistance(x1, y1, x2, y2 float64) float64 {
+	dx := math.Abs(x1 - x2)
+	dy := math.Abs(y1 - y2)
+	return max(dx, dy) + (math.Sqrt2-1)*min(dx, dy)
+}
+
+// ManhattanDistance3D is the sum of the absolute differences of their coordinates in a 3D space
+// |x1 - x2| + |y1 - y2| + |z1 - z2|
+// Helper function
+func ManhattanDistance3D(x1, y1, z1, x2, y2, z2 float64) float64 {
+	return math.Abs(x1-x2) + math.Abs(y1-y2) + math.Abs(z1-z2)
+}
+
+// EuclideanDistance3D calculates the straight-line distance between two points in a 3D space
+// √((x2 - x1)² + (y2 - y1)² + (z2 - z1)²)
+// Helper function
+func EuclideanDistance3D(x1, y1, z1, x2, y2, z2 float64) float64 {
+	x := x2 - x1
+	y := y2 - y1
+	z := z2 - z1
+	return math.Sqrt(x*x + y*y + z*z)
+}
+
+// ChebyshevDistance3D is the greatest of the absolute differences of their coordinates in a 3D space
+// (26 directions moves, all moves cost 1)
+// max(|x1 - x2|, |y1 - y2|, |z1 - z2|)
+// Helper function
+func ChebyshevDistance3D(x1, y1, z1, x2, y2, z2 float64) float64 {
+	return max(math.Abs(x1-x2), math.Abs(y1-y2), math.Abs(z1-z2))
+}
+
+// OctileDistance3D is the length of the shortest path on an open voxel grid
+// (26 directions moves, 2D diagonal moves cost √2, 3D diagonal moves cost √3)
+// with d1 >= d2 >= d3: d1 + (√2 - 1) * d2 + (√3 - √2) * d3
+// Helper function
+func OctileDistance3D(x1, y1, z1, x2, y2, z2 float64) float64 {
+	dx, dy, dz := math.Abs(x1-x2), math.Abs(y1-y2), math.Abs(z1-z2)
+	d1 := max(dx, dy, dz)
+	d3 := min(dx, dy, dz)
+	d2 := max(min(dx, dy), min(max(dx, dy), dz))
+	return d1 + (math.Sqrt2-1)*d2 + (math.Sqrt(3)-math.Sqrt2)*d3
+}
+
+// HexDistance is the number of moves between two hexagons using axial coordinates (q, r)
+// (|q1 - q2| + |r1 - r2| + |s1 - s2|) / 2 with s = -q - r (cube coordinates)
+// Helper function
+func HexDistance(q1, r1, q2, r2 float64) float64 {
+	dq := q1 - q2
+	dr := r1 - r2
+	return (math.Abs(dq) + math.Abs(dr) + math.Abs(dq+dr)) / 2
+}
+
+// Distance2D builds a heuristic distance between 2 nodes using their 2D coordinates
+// * coordinates: give the coordinates of a node
+// * distance:    distance between 2 points (see ManhattanDistance, EuclideanDistance, ...)
+func Distance2D[T any](coordinates func(T) (float64, float64), distance func(x1, y1, x2, y2 float64) float64) func(T, T) float64 {
+	return func(a, b T) float64 {
+		x1, y1 := coordinates(a)
+		x2, y2 := coordinates(b)
+		return distance(x1, y1, x2, y2)
+	}
+}
+
+// Distance3D builds a heuristic distance between 2 nodes using their 3D coordinates
+// * coordinates: give the coordinates of a node
+// * distance:    distance between 2 points (see ManhattanDistance3D, EuclideanDistance3D, ...)
+func Distance3D[T any](coordinates func(T) (float64, float64, float64), distance func(x1, y1, z1, x2, y2, z2 float64) float64) func(T, T) float64 {
+	return func(a, b T) float64 {
+		x1, y1, z1 := coordinates(a)
+		x2, y2, z2 := coordinates(b)
+		return distance(x1, y1, z1, x2, y2, z2)
+	}
+}
@@ -0,0 +1,53 @@
+package astar_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/astar"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDistance(t *testing.T) {
+	Convey("2D distances", t, func() {
+		// from (1, 2) to (4, 6): dx=3, dy=4
+		So(astar.ManhattanDistance(1, 2, 4, 6), ShouldEqual, 7)
+		So(astar.EuclideanDistance(1, 2, 4, 6), ShouldEqual, 5)
+		So(astar.ChebyshevDistance(1, 2, 4, 6), ShouldEqual, 4)
+		So(astar.OctileDistance(1, 2, 4, 6), ShouldAlmostEqual, 1+3*math.Sqrt2)
+	})
+
+	Convey("3D distances", t, func() {
+		// from (0, 0, 0) to (1, -2, 4)
+		So(astar.ManhattanDistance3D(0, 0, 0, 1, -2, 4), ShouldEqual, 7)
+		So(astar.EuclideanDistance3D(0, 0, 0, 2, -3, 6), ShouldEqual, 7)
+		So(astar.ChebyshevDistance3D(0, 0, 0, 1, -2, 4), ShouldEqual, 4)
+		So(astar.OctileDistance3D(0, 0, 0, 1, -2, 4), ShouldAlmostEqual, 2+math.Sqrt2+math.Sqrt(3))
+	})
+
+	Convey("hex distance", t, func() {
+		So(astar.HexDistance(0, 0, 0, 0), ShouldEqual, 0)
+		So(astar.HexDistance(0, 0, 1, -1), ShouldEqual, 1)
+		So(astar.HexDistance(0, 0, 2, 1), ShouldEqual, 3)
+		So(astar.HexDistance(-1, 3, 2, -1), ShouldEqual, 4)
+	})
+
+	Convey("distance builders", t, func() {
+		type point struct {
+			x, y, z int
+		}
+		a := point{x: 1, y: 2, z: 3}
+		b := point{x: 4, y: 6, z: 3}
+
+		distance2D := astar.Distance2D(func(p point) (float64, float64) {
+			return float64(p.x), float64(p.y)
+		}, astar.EuclideanDistance)
+		So(distance2D(a, b), ShouldEqual, 5)
+
+		distance3D := astar.Distance3D(func(p point) (float64, float64, float64) {
+			return float64(p.x), float64(p.y), float64(p.z)
+		}, astar.ManhattanDistance3D)
+		So(distance3D(a, b), ShouldEqual, 7)
+	})
+}
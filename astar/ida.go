@@ -0,0 +1,106 @@
+package astar
+
+import (
+	"math"
+	"slices"
+)
+
+// RunIDA performs the iterative deepening A* search algorithm (IDA*)
+// It uses a memory linear in the path depth (same parameters and result as Run)
+// * start:     first node of the path
+// * goal:      last node of the path
+// * weight:    give the node's weight (can be nil to give all nodes a 0 weight)
+// * distance:  heuristic (estimated) distance between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the found path or nil if nothing is found
+func RunIDA[T comparable](start, goal T, weight func(T) float64, distance func(T, T) float64, neighbors func(T) []T) []T {
+	return RunIDAWithTable(start, goal, weight, distance, neighbors, 0)
+}
+
+// RunIDAWithTable performs the IDA* search algorithm with a bounded transposition table
+// The table stores the lowest cost of the visited nodes, to avoid exploring the same sub-tree twice
+// * tableSize: maximum number of nodes in the table (0 for no table)
+// Returns the found path or nil if nothing is found
+func RunIDAWithTable[T comparable](start, goal T, weight func(T) float64, distance func(T, T) float64, neighbors func(T) []T, tableSize int) []T {
+	s := &ida[T]{
+		goal:      goal,
+		weight:    weight,
+		distance:  distance,
+		neighbors: neighbors,
+		tableSize: tableSize,
+		path:      []T{start},
+		onPath:    map[T]bool{start: true},
+	}
+
+	// Increase the threshold up to the lowest f exceeding the previous one
+	threshold := distance(start, goal)
+	for {
+		if tableSize > 0 {
+			s.table = make(map[T]float64, tableSize)
+		}
+		next, found := s.search(0, threshold)
+		if found {
+			return slices.Clone(s.path)
+		}
+		if math.IsInf(next, 1) {
+			return nil // no path found
+		}
+		threshold = next
+	}
+}
+
+// ida stores the state of the IDA* search
+type ida[T comparable] struct {
+	goal      T
+	weight    func(T) float64
+	distance  func(T, T) float64
+	neighbors func(T) []T
+	tableSize int
+	table     map[T]float64 // lowest cost of the visited nodes (for the current threshold)
+	path      []T           // current path
+	onPath    map[T]bool    // nodes of the current path, to avoid cycles
+}
+
+// search explores the nodes from the last node of the path, with a cost g, up to the threshold
+// Returns true if the goal is found, or the lowest f exceeding the threshold
+func (s *ida[T]) search(g, threshold float64) (float64, bool) {
+	current := s.path[len(s.path)-1]
+	f := g + s.distance(current, s.goal)
+	if f > threshold {
+		return f, false
+	}
+	if current == s.goal {
+		return f, true
+	}
+
+	// Skip the node if already explored with a lower cost
+	if s.table != nil {
+		if old, ok := s.table[current]; ok && g >= old {
+			return math.Inf(1), false
+		}
+		if _, ok := s.table[current]; ok || len(s.table) < s.tableSize {
+			s.table[current] = g
+		}
+	}
+
+	gEstimated := g
+	if s.weight != nil {
+		gEstimated += s.weight(current)
+	}
+	next := math.Inf(1)
+	for _, neighbor := range s.neighbors(current) {
+		if s.onPath[neighbor] {
+			continue // cycle
+		}
+		s.path = append(s.path, neighbor)
+		s.onPath[neighbor] = true
+		t, found := s.search(gEstimated, threshold)
+		if found {
+			return t, true
+		}
+		next = min(next, t)
+		s.path = s.path[:len(s.path)-1]
+		delete(s.onPath, neighbor)
+	}
+	return next, false
+}
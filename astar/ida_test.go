@@ -0,0 +1,110 @@
+package astar_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/astar"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIDAStar(t *testing.T) {
+	// puzzle is a 3x3 sliding puzzle, 0 is the empty tile
+	type puzzle [9]int8
+
+	goal := puzzle{1, 2, 3, 4, 5, 6, 7, 8, 0}
+
+	// sum of the Manhattan distances of each tile to its position in the goal
+	distance := func(a, b puzzle) float64 {
+		var positions [9]int
+		for i, tile := range b {
+			positions[tile] = i
+		}
+		var d float64
+		for i, tile := range a {
+			if tile != 0 {
+				j := positions[tile]
+				d += astar.ManhattanDistance(float64(i%3), float64(i/3), float64(j%3), float64(j/3))
+			}
+		}
+		return d
+	}
+
+	// move the empty tile up, down, left or right
+	neighbors := func(p puzzle) []puzzle {
+		var empty int
+		for i, tile := range p {
+			if tile == 0 {
+				empty = i
+			}
+		}
+		var result []puzzle
+		swap := func(i int) {
+			n := p
+			n[empty], n[i] = n[i], n[empty]
+			result = append(result, n)
+		}
+		if empty >= 3 {
+			swap(empty - 3)
+		}
+		if empty < 6 {
+			swap(empty + 3)
+		}
+		if empty%3 > 0 {
+			swap(empty - 1)
+		}
+		if empty%3 < 2 {
+			swap(empty + 1)
+		}
+		return result
+	}
+
+	// each move costs 1
+	weight := func(puzzle) float64 { return 1 }
+
+	// isValid checks that each step of the path is a move
+	isValid := func(path []puzzle) bool {
+		for i := 1; i < len(path); i++ {
+			found := false
+			for _, n := range neighbors(path[i-1]) {
+				found = found || n == path[i]
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	start := puzzle{8, 6, 7, 2, 5, 4, 3, 0, 1} // 31 moves (hardest 8-puzzle)
+
+	Convey("IDA*", t, func() {
+		Convey("when already solved", func() {
+			So(astar.RunIDA(goal, goal, weight, distance, neighbors), ShouldResemble, []puzzle{goal})
+		})
+
+		Convey("when easy puzzle", func() {
+			start := puzzle{1, 2, 3, 4, 0, 6, 7, 5, 8}
+			path := astar.RunIDA(start, goal, weight, distance, neighbors)
+			So(path, ShouldResemble, []puzzle{start, {1, 2, 3, 4, 5, 6, 7, 0, 8}, goal})
+		})
+
+		Convey("when same path length as A*", func() {
+			path := astar.RunIDAWithTable(start, goal, weight, distance, neighbors, 100000)
+			So(isValid(path), ShouldBeTrue)
+			So(path[0], ShouldEqual, start)
+			So(path[len(path)-1], ShouldEqual, goal)
+			So(path, ShouldHaveLength, len(astar.Run(start, goal, weight, distance, neighbors)))
+			So(path, ShouldHaveLength, 32)
+		})
+
+		Convey("when no path", func() {
+			// a <-> b <-> c, d is not reachable
+			neighbors := map[string][]string{"a": {"b"}, "b": {"a", "c"}, "c": {"b"}}
+			path := astar.RunIDA("a", "d", nil, func(a, b string) float64 { return 0 }, func(n string) []string {
+				return neighbors[n]
+			})
+			So(path, ShouldBeNil)
+		})
+	})
+}
@@ -1,32 +1,34 @@
 package astar
 
+import "github.com/sbiemont/grapo/dijkstra"
+
 // priorityQueue implements a priority queue for A* algorithm
-type priorityQueue[T comparable] []*node[T]
+type priorityQueue[T comparable, C dijkstra.Number] []*node[T, C]
 
 // Len returns the length of the priority queue
-func (q priorityQueue[T]) Len() int { return len(q) }
+func (q priorityQueue[T, C]) Len() int { return len(q) }
 
 // Less compares two items in the queue
-func (q priorityQueue[T]) Less(i, j int) bool {
+func (q priorityQueue[T, C]) Less(i, j int) bool {
 	return q[i].f < q[j].f
 }
 
 // Swap swaps two items in the priority queue
-func (q priorityQueue[T]) Swap(i, j int) {
+func (q priorityQueue[T, C]) Swap(i, j int) {
 	q[i], q[j] = q[j], q[i]
 	q[i].index = i
 	q[j].index = j
 }
 
 // Push adds an item to the priority queue
-func (q *priorityQueue[T]) Push(x any) {
-	item := x.(*node[T])
+func (q *priorityQueue[T, C]) Push(x any) {
+	item := x.(*node[T, C])
 	item.index = len(*q)
 	*q = append(*q, item)
 }
 
 // Pop removes and returns the item with the highest priority
-func (q *priorityQueue[T]) Pop() any {
+func (q *priorityQueue[T, C]) Pop() any {
 	old := *q
 	n := len(old)
 	item := old[n-1]
@@ -0,0 +1,100 @@
+package astar
+
+import (
+	"container/heap"
+)
+
+// RunTheta performs the Theta* search algorithm, to find any-angle paths
+// When a node's parent is in sight of a neighbor, the neighbor is directly linked to this parent
+// * start:       first node of the path
+// * goal:        last node of the path
+// * cost:        give the cost of a move between 2 nodes in sight (not only neighbors)
+// * distance:    heuristic (estimated) distance between 2 nodes
+// * neighbors:   list of unordered neighbors of the given node
+// * lineOfSight: check if a straight move is possible between 2 nodes
+// Returns the found path (only the turning points) or nil if nothing is found
+func RunTheta[T comparable](start, goal T, cost func(T, T) float64, distance func(T, T) float64, neighbors func(T) []T, lineOfSight func(T, T) bool) []T {
+	// Initialize opened and closed lists
+	c := newConverter[T, float64]()
+	startNode := c.fetch(start)
+	openedList := map[*node[T, float64]]struct{}{startNode: {}}
+	closedList := map[*node[T, float64]]struct{}{}
+	queue := &priorityQueue[T, float64]{}
+	heap.Init(queue)
+
+	// Initialize node properties
+	startNode.h = distance(start, goal)
+	startNode.f = startNode.h
+	startNode.parent = startNode // the start is its own parent, for the line of sight check
+	heap.Push(queue, startNode)
+
+	goalNode := c.fetch(goal)
+	for queue.Len() > 0 {
+		// Get node with lowest f value (from prority queue)
+		currentNode := heap.Pop(queue).(*node[T, float64])
+		if currentNode == goalNode {
+			return path(startNode, currentNode)
+		}
+		delete(openedList, currentNode)
+		closedList[currentNode] = struct{}{}
+
+		// Check all neighboring nodes
+		for _, neighbor := range neighbors(currentNode.value) {
+			neighborNode := c.fetch(neighbor)
+			if _, ok := closedList[neighborNode]; ok {
+				continue // Skip already evaluated nodes
+			}
+
+			// Link the neighbor to the current node's parent if in sight
+			parentNode := currentNode
+			if lineOfSight(currentNode.parent.value, neighbor) {
+				parentNode = currentNode.parent
+			}
+			gEstimated := parentNode.g + cost(parentNode.value, neighbor)
+
+			_, opened := openedList[neighborNode]
+			switch {
+			case !opened:
+				openedList[neighborNode] = struct{}{} // add neighbor to opened list
+			case gEstimated >= neighborNode.g:
+				continue // bad path, next node
+			default:
+				heap.Remove(queue, neighborNode.index) // remove neighbor from priority queue
+			}
+
+			// Best current path
+			neighborNode.parent = parentNode
+			neighborNode.g = gEstimated
+			neighborNode.h = distance(neighbor, goal)
+			neighborNode.f = neighborNode.g + neighborNode.h
+			heap.Push(queue, neighborNode)
+		}
+	}
+	return nil // no path found
+}
+
+// Smooth removes the redundant waypoints of a path
+// Each waypoint is directly linked to the farthest next waypoint in sight
+// * path:        list of nodes
+// * lineOfSight: check if a straight move is possible between 2 nodes
+// Returns the smoothed path
+func Smooth[T comparable](path []T, lineOfSight func(T, T) bool) []T {
+	if len(path) <= 2 {
+		return path
+	}
+
+	smoothed := []T{path[0]}
+	for i := 0; i < len(path)-1; {
+		// Find the farthest waypoint in sight (the next one is always reachable)
+		next := i + 1
+		for j := len(path) - 1; j > next; j-- {
+			if lineOfSight(path[i], path[j]) {
+				next = j
+				break
+			}
+		}
+		smoothed = append(smoothed, path[next])
+		i = next
+	}
+	return smoothed
+}
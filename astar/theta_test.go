@@ -0,0 +1,92 @@
+package astar_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/astar"
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTheta(t *testing.T) {
+	euclidean := func(a, b grid.Cell) float64 {
+		return astar.EuclideanDistance(float64(a.X), float64(a.Y), float64(b.X), float64(b.Y))
+	}
+
+	// pathCost is the total length of the path, or -1 if 2 waypoints are not in sight
+	pathCost := func(g *grid.Grid, path []grid.Cell) float64 {
+		var total float64
+		for i := 1; i < len(path); i++ {
+			if !g.LineOfSight(path[i-1], path[i]) {
+				return -1
+			}
+			total += euclidean(path[i-1], path[i])
+		}
+		return total
+	}
+
+	Convey("theta*", t, func() {
+		Convey("when open grid", func() {
+			g := grid.New(10, 10)
+			g.Connectivity = grid.EightWay
+			start, goal := grid.Cell{X: 0, Y: 0}, grid.Cell{X: 9, Y: 4}
+			path := astar.RunTheta(start, goal, euclidean, euclidean, g.Neighbors, g.LineOfSight)
+			So(path, ShouldResemble, []grid.Cell{start, goal})
+		})
+
+		Convey("when obstacles", func() {
+			g, err := grid.Parse(`
+..........
+..........
+..#######.
+..........
+..........
+`)
+			So(err, ShouldBeNil)
+			g.Connectivity = grid.EightWay
+			g.Corners = grid.NoCornerCutting
+			start, goal := grid.Cell{X: 9, Y: 0}, grid.Cell{X: 5, Y: 4}
+
+			path := astar.RunTheta(start, goal, euclidean, euclidean, g.Neighbors, g.LineOfSight)
+			So(path, ShouldResemble, []grid.Cell{start, {X: 9, Y: 3}, goal})
+
+			// shorter than the grid path
+			gridPath := astar.RunWithCost(start, goal, g.MoveCost, g.Distance, g.Neighbors)
+			So(pathCost(g, path), ShouldBeLessThan, pathCost(g, gridPath))
+		})
+
+		Convey("when no path", func() {
+			g, err := grid.Parse(".#.\n.#.")
+			So(err, ShouldBeNil)
+			path := astar.RunTheta(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 2, Y: 0}, euclidean, euclidean, g.Neighbors, g.LineOfSight)
+			So(path, ShouldBeNil)
+		})
+	})
+
+	Convey("smooth", t, func() {
+		Convey("when short path", func() {
+			So(astar.Smooth([]int{1, 2}, nil), ShouldResemble, []int{1, 2})
+		})
+
+		Convey("when generic path", func() {
+			// nodes in sight if close enough
+			lineOfSight := func(a, b int) bool { return b-a <= 3 }
+			So(astar.Smooth([]int{0, 1, 2, 3, 4, 5, 6, 7}, lineOfSight), ShouldResemble, []int{0, 3, 6, 7})
+		})
+
+		Convey("when grid path", func() {
+			g, err := grid.Parse(`
+......
+.####.
+......
+`)
+			So(err, ShouldBeNil)
+			path := g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 5, Y: 2})
+			So(path, ShouldHaveLength, 8)
+
+			smoothed := astar.Smooth(path, g.LineOfSight)
+			So(smoothed, ShouldResemble, []grid.Cell{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 5, Y: 2}})
+		})
+	})
+}
@@ -0,0 +1,84 @@
+package bipartite
+
+import "math"
+
+// HopcroftKarp finds a maximum cardinality matching in a bipartite graph
+// * edges: list of right nodes that can be matched with each left node
+// Returns the matched pairs (unordered)
+func HopcroftKarp[L, R comparable](edges map[L][]R) []Pair[L, R] {
+	hk := &hopcroftKarp[L, R]{
+		edges:  edges,
+		matchL: make(map[L]R),
+		matchR: make(map[R]L),
+		dist:   make(map[L]int),
+	}
+
+	// Augment the matching while shortest augmenting paths exist
+	for hk.bfs() {
+		for left := range edges {
+			if _, ok := hk.matchL[left]; !ok {
+				hk.dfs(left)
+			}
+		}
+	}
+
+	pairs := make([]Pair[L, R], 0, len(hk.matchL))
+	for left, right := range hk.matchL {
+		pairs = append(pairs, Pair[L, R]{Left: left, Right: right})
+	}
+	return pairs
+}
+
+// hopcroftKarp stores the current state of the algorithm
+type hopcroftKarp[L, R comparable] struct {
+	edges  map[L][]R
+	matchL map[L]R   // right node matched with a left node
+	matchR map[R]L   // left node matched with a right node
+	dist   map[L]int // layer of each left node in the alternating paths
+}
+
+// bfs builds layers of left nodes, starting with the free ones
+// Returns true if at least one augmenting path exists
+func (hk *hopcroftKarp[L, R]) bfs() bool {
+	var queue []L
+	for left := range hk.edges {
+		if _, ok := hk.matchL[left]; ok {
+			hk.dist[left] = math.MaxInt
+		} else {
+			hk.dist[left] = 0
+			queue = append(queue, left)
+		}
+	}
+
+	found := false
+	for len(queue) > 0 {
+		left := queue[0]
+		queue = queue[1:]
+		for _, right := range hk.edges[left] {
+			next, ok := hk.matchR[right]
+			switch {
+			case !ok:
+				found = true // free right node: augmenting path
+			case hk.dist[next] == math.MaxInt:
+				hk.dist[next] = hk.dist[left] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return found
+}
+
+// dfs looks for an augmenting path from the given left node following the layers
+// Returns true if the matching has been augmented
+func (hk *hopcroftKarp[L, R]) dfs(left L) bool {
+	for _, right := range hk.edges[left] {
+		next, ok := hk.matchR[right]
+		if !ok || (hk.dist[next] == hk.dist[left]+1 && hk.dfs(next)) {
+			hk.matchL[left] = right
+			hk.matchR[right] = left
+			return true
+		}
+	}
+	hk.dist[left] = math.MaxInt // dead end, do not visit it again during this phase
+	return false
+}
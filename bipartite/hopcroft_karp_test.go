@@ -0,0 +1,67 @@
+package bipartite_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/bipartite"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// isMatching checks that each node is matched at most once and only using existing edges
+func isMatching[L, R comparable](edges map[L][]R, pairs []bipartite.Pair[L, R]) bool {
+	lefts := make(map[L]bool)
+	rights := make(map[R]bool)
+	for _, p := range pairs {
+		if lefts[p.Left] || rights[p.Right] {
+			return false
+		}
+		lefts[p.Left] = true
+		rights[p.Right] = true
+
+		found := false
+		for _, r := range edges[p.Left] {
+			found = found || r == p.Right
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	Convey("hopcroft karp", t, func() {
+		Convey("when no edge", func() {
+			pairs := bipartite.HopcroftKarp[string, int](nil)
+			So(pairs, ShouldBeEmpty)
+		})
+
+		Convey("when perfect matching", func() {
+			// a greedy matching (alice-1, bob-2) blocks carol
+			edges := map[string][]int{
+				"alice": {1, 2},
+				"bob":   {2, 3},
+				"carol": {1},
+			}
+			pairs := bipartite.HopcroftKarp(edges)
+			So(isMatching(edges, pairs), ShouldBeTrue)
+			So(pairs, ShouldHaveLength, 3)
+			So(pairs, ShouldContain, bipartite.Pair[string, int]{Left: "carol", Right: 1})
+			So(pairs, ShouldContain, bipartite.Pair[string, int]{Left: "alice", Right: 2})
+			So(pairs, ShouldContain, bipartite.Pair[string, int]{Left: "bob", Right: 3})
+		})
+
+		Convey("when not all nodes can be matched", func() {
+			edges := map[string][]int{
+				"alice": {1},
+				"bob":   {1},
+				"carol": {1, 2},
+				"dave":  {2},
+			}
+			pairs := bipartite.HopcroftKarp(edges)
+			So(isMatching(edges, pairs), ShouldBeTrue)
+			So(pairs, ShouldHaveLength, 2)
+		})
+	})
+}
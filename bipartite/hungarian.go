@@ -0,0 +1,146 @@
+package bipartite
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	ErrInvalidMatrix = fmt.Errorf("invalid cost matrix")
+)
+
+// Hungarian finds the minimum-cost assignment of the rows to the columns of a cost matrix
+// Each row is assigned to a distinct column (or each column to a distinct row if there are more rows)
+// * costs: cost of assigning row i to column j (all rows must have the same length)
+// Returns the assigned (row, column) pairs ordered by row and the total cost
+func Hungarian(costs [][]float64) ([]Pair[int, int], float64, error) {
+	if len(costs) == 0 || len(costs[0]) == 0 {
+		return nil, 0, nil
+	}
+	for _, row := range costs {
+		if len(row) != len(costs[0]) {
+			return nil, 0, ErrInvalidMatrix
+		}
+	}
+
+	// The algorithm requires less rows than columns: transpose if needed
+	n, m := len(costs), len(costs[0])
+	cost := func(i, j int) float64 { return costs[i][j] }
+	transposed := n > m
+	if transposed {
+		n, m = m, n
+		cost = func(i, j int) float64 { return costs[j][i] }
+	}
+
+	rowOf := hungarian(n, m, cost)
+
+	// Build the pairs
+	var pairs []Pair[int, int]
+	var total float64
+	if transposed {
+		// Columns of the transposed matrix are the rows: already ordered
+		for row, col := range rowOf {
+			if col >= 0 {
+				pairs = append(pairs, Pair[int, int]{Left: row, Right: col})
+			}
+		}
+	} else {
+		colOf := make([]int, n)
+		for col, row := range rowOf {
+			if row >= 0 {
+				colOf[row] = col
+			}
+		}
+		for row, col := range colOf {
+			pairs = append(pairs, Pair[int, int]{Left: row, Right: col})
+		}
+	}
+	for _, p := range pairs {
+		total += costs[p.Left][p.Right]
+	}
+	return pairs, total, nil
+}
+
+// HungarianFunc finds the minimum-cost assignment between left and right nodes
+// * left:  list of left nodes
+// * right: list of right nodes
+// * cost:  cost of assigning a left node to a right node
+// Returns the assigned pairs and the total cost
+func HungarianFunc[L, R any](left []L, right []R, cost func(L, R) float64) ([]Pair[L, R], float64) {
+	costs := make([][]float64, len(left))
+	for i, l := range left {
+		costs[i] = make([]float64, len(right))
+		for j, r := range right {
+			costs[i][j] = cost(l, r)
+		}
+	}
+
+	indexes, total, _ := Hungarian(costs) // the matrix is always valid
+	pairs := make([]Pair[L, R], len(indexes))
+	for k, p := range indexes {
+		pairs[k] = Pair[L, R]{Left: left[p.Left], Right: right[p.Right]}
+	}
+	return pairs, total
+}
+
+// hungarian is the O(n²m) algorithm using potentials on a n x m matrix (n <= m)
+// Returns for each assigned column, the index of its row (-1 if not assigned)
+func hungarian(n, m int, cost func(i, j int) float64) []int {
+	// Work with 1-based indexes, index 0 is a fictive row/column
+	u := make([]float64, n+1) // row potentials
+	v := make([]float64, m+1) // column potentials
+	p := make([]int, m+1)     // row assigned to each column
+	way := make([]int, m+1)   // previous column in the augmenting path
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+
+		// Find an augmenting path from row i
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], math.Inf(1), 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				cur := cost(i0-1, j-1) - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+
+		// Invert the assignment along the path
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	rowOf := make([]int, m)
+	for j := 1; j <= m; j++ {
+		rowOf[j-1] = p[j] - 1
+	}
+	return rowOf
+}
@@ -0,0 +1,80 @@
+package bipartite_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/bipartite"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHungarian(t *testing.T) {
+	type pair = bipartite.Pair[int, int]
+
+	Convey("hungarian", t, func() {
+		Convey("when empty matrix", func() {
+			pairs, total, err := bipartite.Hungarian(nil)
+			So(err, ShouldBeNil)
+			So(pairs, ShouldBeEmpty)
+			So(total, ShouldEqual, 0)
+		})
+
+		Convey("when square matrix", func() {
+			pairs, total, err := bipartite.Hungarian([][]float64{
+				{4, 1, 3},
+				{2, 0, 5},
+				{3, 2, 2},
+			})
+			So(err, ShouldBeNil)
+			So(pairs, ShouldResemble, []pair{{0, 1}, {1, 0}, {2, 2}})
+			So(total, ShouldEqual, 5)
+		})
+
+		Convey("when more columns than rows", func() {
+			pairs, total, err := bipartite.Hungarian([][]float64{
+				{9, 2, 7, 1},
+				{6, 4, 3, 8},
+			})
+			So(err, ShouldBeNil)
+			So(pairs, ShouldResemble, []pair{{0, 3}, {1, 2}})
+			So(total, ShouldEqual, 4)
+		})
+
+		Convey("when more rows than columns", func() {
+			pairs, total, err := bipartite.Hungarian([][]float64{
+				{9, 6},
+				{2, 4},
+				{7, 3},
+				{1, 8},
+			})
+			So(err, ShouldBeNil)
+			So(pairs, ShouldResemble, []pair{{2, 1}, {3, 0}})
+			So(total, ShouldEqual, 4)
+		})
+
+		Convey("when invalid matrix", func() {
+			_, _, err := bipartite.Hungarian([][]float64{{1, 2}, {3}})
+			So(err, ShouldBeError, bipartite.ErrInvalidMatrix.Error())
+		})
+	})
+
+	Convey("hungarian with callback", t, func() {
+		workers := []string{"alice", "bob", "carol"}
+		tasks := []string{"clean", "cook", "wash"}
+		costs := map[string]map[string]float64{
+			"alice": {"clean": 2, "cook": 3, "wash": 3},
+			"bob":   {"clean": 3, "cook": 2, "wash": 3},
+			"carol": {"clean": 3, "cook": 3, "wash": 2},
+		}
+
+		pairs, total := bipartite.HungarianFunc(workers, tasks, func(w, t string) float64 {
+			return costs[w][t]
+		})
+		So(pairs, ShouldResemble, []bipartite.Pair[string, string]{
+			{Left: "alice", Right: "clean"},
+			{Left: "bob", Right: "cook"},
+			{Left: "carol", Right: "wash"},
+		})
+		So(total, ShouldEqual, 6)
+	})
+}
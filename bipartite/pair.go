@@ -0,0 +1,7 @@
+package bipartite
+
+// Pair is a matched couple of a left node and a right node
+type Pair[L, R any] struct {
+	Left  L
+	Right R
+}
@@ -0,0 +1,298 @@
+package ch
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"slices"
+)
+
+var (
+	ErrNegativeWeight = fmt.Errorf("negative edge weight")
+)
+
+// witnessLimit is the maximum number of settled nodes during a witness search
+const witnessLimit = 500
+
+// Edge is a directed edge of the graph
+type Edge[T comparable] struct {
+	From   T       // tail of the edge
+	To     T       // head of the edge
+	Weight float64 // length of the edge
+}
+
+// arc is an edge or a shortcut between 2 nodes
+type arc struct {
+	weight float64
+	middle int // contracted node bypassed by the shortcut (-1 for an original edge)
+}
+
+// Hierarchy is a contraction hierarchy of a directed graph, for fast repeated shortest path queries
+// Nodes are contracted one by one, shortcuts preserve the shortest distances between the remaining nodes
+type Hierarchy[T comparable] struct {
+	nodes []T           // value of each node
+	index map[T]int     // index of each node
+	rank  []int         // contraction order of each node
+	out   []map[int]arc // arcs leaving each node (edges and shortcuts)
+	in    []map[int]arc // arcs reaching each node (edges and shortcuts)
+}
+
+// New builds the contraction hierarchy of the graph
+// Nodes are ordered by edge difference (added shortcuts minus removed edges) and by number of contracted neighbors
+// * edges: directed edges of the graph (add both directions for an undirected graph)
+func New[T comparable](edges []Edge[T]) (*Hierarchy[T], error) {
+	h := &Hierarchy[T]{index: make(map[T]int)}
+	for _, e := range edges {
+		if e.Weight < 0 {
+			return nil, ErrNegativeWeight
+		}
+		from, to := h.add(e.From), h.add(e.To)
+		if from != to {
+			h.link(from, to, e.Weight, -1)
+		}
+	}
+
+	// Contract the least important node first (lazy updates of the importance)
+	h.rank = make([]int, len(h.nodes))
+	contracted := make([]bool, len(h.nodes))
+	deleted := make([]int, len(h.nodes)) // number of contracted neighbors
+	iqueue := &itemQueue{}
+	for v := range h.nodes {
+		*iqueue = append(*iqueue, item{node: v, key: h.importance(v, contracted, deleted)})
+	}
+	heap.Init(iqueue)
+	for order := 0; iqueue.Len() > 0; {
+		it := heap.Pop(iqueue).(item)
+		v := it.node
+		if key := h.importance(v, contracted, deleted); iqueue.Len() > 0 && key > (*iqueue)[0].key {
+			heap.Push(iqueue, item{node: v, key: key})
+			continue
+		}
+
+		for _, s := range h.shortcuts(v, contracted) {
+			h.link(s[0], s[1], h.in[v][s[0]].weight+h.out[v][s[1]].weight, v)
+		}
+		contracted[v] = true
+		for _, n := range h.neighbors(v) {
+			deleted[n]++
+		}
+		h.rank[v] = order
+		order++
+	}
+	return h, nil
+}
+
+// Path finds the shortest path from start to goal with a bidirectional search, upward in the hierarchy
+// Returns the full path (shortcuts unpacked) and its length, or nil if nothing is found
+func (h *Hierarchy[T]) Path(start, goal T) ([]T, float64) {
+	s, ok1 := h.index[start]
+	t, ok2 := h.index[goal]
+	if !ok1 || !ok2 {
+		return nil, 0
+	}
+
+	// Search forward from start and backward from goal, only to higher ranked nodes
+	forward := newSearch(s, h.out, h.rank)
+	backward := newSearch(t, h.in, h.rank)
+	best, meeting := math.Inf(1), -1
+	for forward.active(best) || backward.active(best) {
+		for _, search := range []*search{forward, backward} {
+			if !search.active(best) {
+				continue
+			}
+			v := search.step()
+			other := forward
+			if search == forward {
+				other = backward
+			}
+			if d, ok := other.dist[v]; ok && search.dist[v]+d < best {
+				best, meeting = search.dist[v]+d, v
+			}
+		}
+	}
+	if meeting < 0 {
+		return nil, 0
+	}
+
+	// Join both half paths, then unpack the shortcuts
+	var ids []int
+	for v := meeting; v != s; v = forward.prev[v] {
+		ids = append(ids, v)
+	}
+	ids = append(ids, s)
+	slices.Reverse(ids)
+	for v := meeting; v != t; {
+		v = backward.prev[v]
+		ids = append(ids, v)
+	}
+
+	path := []T{h.nodes[ids[0]]}
+	for i := 1; i < len(ids); i++ {
+		path = h.unpack(ids[i-1], ids[i], path)
+	}
+	return path, best
+}
+
+// add retrieves the index of the node or adds a new node
+func (h *Hierarchy[T]) add(n T) int {
+	if i, ok := h.index[n]; ok {
+		return i
+	}
+	h.index[n] = len(h.nodes)
+	h.nodes = append(h.nodes, n)
+	h.out = append(h.out, make(map[int]arc))
+	h.in = append(h.in, make(map[int]arc))
+	return len(h.nodes) - 1
+}
+
+// link adds an arc between 2 nodes, or updates the existing one if the new arc is shorter
+func (h *Hierarchy[T]) link(from, to int, weight float64, middle int) {
+	if a, ok := h.out[from][to]; ok && a.weight <= weight {
+		return
+	}
+	h.out[from][to] = arc{weight: weight, middle: middle}
+	h.in[to][from] = arc{weight: weight, middle: middle}
+}
+
+// neighbors lists the nodes linked to the node (in both directions)
+func (h *Hierarchy[T]) neighbors(v int) []int {
+	var res []int
+	for n := range h.out[v] {
+		res = append(res, n)
+	}
+	for n := range h.in[v] {
+		if _, ok := h.out[v][n]; !ok {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+// importance computes the priority of contraction of the node: edge difference plus contracted neighbors
+func (h *Hierarchy[T]) importance(v int, contracted []bool, deleted []int) float64 {
+	removed := 0
+	for _, n := range h.neighbors(v) {
+		if !contracted[n] {
+			removed++
+		}
+	}
+	return float64(len(h.shortcuts(v, contracted))-removed) + float64(deleted[v])
+}
+
+// shortcuts lists the pairs of nodes (u, w) needing a shortcut u -> v -> w when contracting v
+// A shortcut is not needed when a witness path, shorter and avoiding v, is found
+func (h *Hierarchy[T]) shortcuts(v int, contracted []bool) [][2]int {
+	var pairs [][2]int
+	for u, in := range h.in[v] {
+		if contracted[u] {
+			continue
+		}
+
+		// Witness search from u, up to the longest path through v
+		limit := 0.0
+		for w, out := range h.out[v] {
+			if !contracted[w] && w != u {
+				limit = max(limit, in.weight+out.weight)
+			}
+		}
+		dist := h.witness(u, v, limit, contracted)
+		for w, out := range h.out[v] {
+			if contracted[w] || w == u {
+				continue
+			}
+			if d, ok := dist[w]; !ok || d > in.weight+out.weight {
+				pairs = append(pairs, [2]int{u, w})
+			}
+		}
+	}
+	return pairs
+}
+
+// witness computes the distances from a node, avoiding the contracted nodes and the given node, up to the limit
+func (h *Hierarchy[T]) witness(from, avoid int, limit float64, contracted []bool) map[int]float64 {
+	dist := make(map[int]float64)
+	tentative := map[int]float64{from: 0}
+	iqueue := &itemQueue{{node: from}}
+	for iqueue.Len() > 0 && len(dist) < witnessLimit {
+		it := heap.Pop(iqueue).(item)
+		if _, ok := dist[it.node]; ok {
+			continue
+		}
+		if it.key > limit {
+			break
+		}
+		dist[it.node] = it.key
+		for n, a := range h.out[it.node] {
+			if n == avoid || contracted[n] {
+				continue
+			}
+			if _, ok := dist[n]; ok {
+				continue
+			}
+			d := it.key + a.weight
+			if old, ok := tentative[n]; ok && old <= d {
+				continue
+			}
+			tentative[n] = d
+			heap.Push(iqueue, item{node: n, key: d})
+		}
+	}
+	return dist
+}
+
+// unpack appends the original nodes of the arc (without its tail) to the path
+func (h *Hierarchy[T]) unpack(from, to int, path []T) []T {
+	m := h.out[from][to].middle
+	if m < 0 {
+		return append(path, h.nodes[to])
+	}
+	path = h.unpack(from, m, path)
+	return h.unpack(m, to, path)
+}
+
+// search is one direction of the bidirectional query
+type search struct {
+	arcs  []map[int]arc // arcs to follow
+	rank  []int         // contraction order of each node
+	dist  map[int]float64
+	prev  map[int]int
+	queue *itemQueue
+}
+
+// newSearch initializes a search from the given node
+func newSearch(from int, arcs []map[int]arc, rank []int) *search {
+	return &search{
+		arcs:  arcs,
+		rank:  rank,
+		dist:  map[int]float64{from: 0},
+		prev:  make(map[int]int),
+		queue: &itemQueue{{node: from}},
+	}
+}
+
+// active checks if the search can still find a path shorter than the best one
+func (s *search) active(best float64) bool {
+	return s.queue.Len() > 0 && (*s.queue)[0].key < best
+}
+
+// step settles the next node and relaxes its arcs to higher ranked nodes
+// Returns the settled node
+func (s *search) step() int {
+	it := heap.Pop(s.queue).(item)
+	if it.key > s.dist[it.node] {
+		return it.node // outdated
+	}
+	for n, a := range s.arcs[it.node] {
+		if s.rank[n] < s.rank[it.node] {
+			continue
+		}
+		d := it.key + a.weight
+		if old, ok := s.dist[n]; ok && old <= d {
+			continue
+		}
+		s.dist[n] = d
+		s.prev[n] = it.node
+		heap.Push(s.queue, item{node: n, key: d})
+	}
+	return it.node
+}
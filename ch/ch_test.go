@@ -0,0 +1,147 @@
+package ch_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sbiemont/grapo/ch"
+	"github.com/sbiemont/grapo/dijkstra"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// randomGraph builds a directed graph with random edges
+func randomGraph(size, degree int, seed int64) []ch.Edge[int] {
+	rnd := rand.New(rand.NewSource(seed))
+	var edges []ch.Edge[int]
+	for i := range size {
+		for range degree {
+			edges = append(edges, ch.Edge[int]{From: i, To: rnd.Intn(size), Weight: float64(1 + rnd.Intn(20))})
+		}
+	}
+	return edges
+}
+
+// roadGraph builds a grid with random weights in both directions
+func roadGraph(size int, seed int64) []ch.Edge[int] {
+	rnd := rand.New(rand.NewSource(seed))
+	var edges []ch.Edge[int]
+	for y := range size {
+		for x := range size {
+			for _, n := range [][2]int{{x + 1, y}, {x, y + 1}} {
+				if n[0] < size && n[1] < size {
+					w := float64(1 + rnd.Intn(10))
+					edges = append(edges,
+						ch.Edge[int]{From: y*size + x, To: n[1]*size + n[0], Weight: w},
+						ch.Edge[int]{From: n[1]*size + n[0], To: y*size + x, Weight: w},
+					)
+				}
+			}
+		}
+	}
+	return edges
+}
+
+// adjacency builds the neighbors function of the edges (keeping the shortest parallel edge)
+func adjacency(edges []ch.Edge[int]) func(int) map[int]float64 {
+	adj := make(map[int]map[int]float64)
+	for _, e := range edges {
+		if adj[e.From] == nil {
+			adj[e.From] = make(map[int]float64)
+		}
+		if old, ok := adj[e.From][e.To]; !ok || e.Weight < old {
+			adj[e.From][e.To] = e.Weight
+		}
+	}
+	return func(n int) map[int]float64 { return adj[n] }
+}
+
+func TestContractionHierarchy(t *testing.T) {
+	Convey("when small graph", t, func() {
+		// a -1-> b -1-> c -1-> d
+		// a ------5-----------> d
+		h, err := ch.New([]ch.Edge[string]{
+			{From: "a", To: "b", Weight: 1},
+			{From: "b", To: "c", Weight: 1},
+			{From: "c", To: "d", Weight: 1},
+			{From: "a", To: "d", Weight: 5},
+		})
+		So(err, ShouldBeNil)
+
+		path, dist := h.Path("a", "d")
+		So(path, ShouldResemble, []string{"a", "b", "c", "d"})
+		So(dist, ShouldEqual, 3)
+
+		path, dist = h.Path("b", "b")
+		So(path, ShouldResemble, []string{"b"})
+		So(dist, ShouldEqual, 0)
+
+		path, _ = h.Path("d", "a")
+		So(path, ShouldBeNil)
+		path, _ = h.Path("a", "unknown")
+		So(path, ShouldBeNil)
+	})
+
+	Convey("when negative weight", t, func() {
+		_, err := ch.New([]ch.Edge[int]{{From: 1, To: 2, Weight: -1}})
+		So(err, ShouldBeError, ch.ErrNegativeWeight.Error())
+	})
+
+	for name, graph := range map[string]struct {
+		edges []ch.Edge[int]
+		size  int
+	}{
+		"random graph": {randomGraph(200, 3, 1), 200},
+		"road graph":   {roadGraph(15, 2), 15 * 15},
+	} {
+		Convey("same distances as dijkstra on "+name, t, func() {
+			h, err := ch.New(graph.edges)
+			So(err, ShouldBeNil)
+			neighbors := adjacency(graph.edges)
+
+			rnd := rand.New(rand.NewSource(3))
+			for range 100 {
+				start, goal := rnd.Intn(graph.size), rnd.Intn(graph.size)
+				expected, ok := func() (float64, bool) {
+					dist, _ := dijkstra.ShortestPaths(start, nil, neighbors)
+					d, ok := dist[goal]
+					return d, ok
+				}()
+
+				path, dist := h.Path(start, goal)
+				if !ok {
+					So(path, ShouldBeNil)
+					continue
+				}
+				So(dist, ShouldAlmostEqual, expected)
+
+				// the unpacked path only uses original edges
+				So(path[0], ShouldEqual, start)
+				So(path[len(path)-1], ShouldEqual, goal)
+				var total float64
+				for i := 1; i < len(path); i++ {
+					w, ok := neighbors(path[i-1])[path[i]]
+					So(ok, ShouldBeTrue)
+					total += w
+				}
+				So(math.Abs(total-dist), ShouldBeLessThan, 1e-9)
+			}
+		})
+	}
+}
+
+func BenchmarkDijkstraQuery(b *testing.B) {
+	neighbors := adjacency(roadGraph(50, 1))
+	for i := range b.N {
+		dijkstra.RunNumeric(i%2500, 2499-i%2500, nil, neighbors)
+	}
+}
+
+func BenchmarkContractionHierarchyQuery(b *testing.B) {
+	h, _ := ch.New(roadGraph(50, 1))
+	b.ResetTimer()
+	for i := range b.N {
+		h.Path(i%2500, 2499-i%2500)
+	}
+}
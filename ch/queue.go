@@ -0,0 +1,24 @@
+package ch
+
+// item is a node with its priority
+type item struct {
+	node int     // index of the node
+	key  float64 // priority of the node (distance or importance)
+}
+
+// itemQueue is a list of nodes ordered by priority
+// Implement heap.Interface for itemQueue
+type itemQueue []item
+
+func (q itemQueue) Len() int           { return len(q) }
+func (q itemQueue) Less(i, j int) bool { return q[i].key < q[j].key }
+func (q itemQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *itemQueue) Push(x any)        { *q = append(*q, x.(item)) }
+
+func (q *itemQueue) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[0 : n-1]
+	return x
+}
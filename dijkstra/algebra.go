@@ -0,0 +1,124 @@
+package dijkstra
+
+import (
+	"math"
+)
+
+// Algebra defines how the values of the edges are combined along a path, and how paths are compared
+// Combining a path with an edge no better than the identity shall never give a better path:
+// such an extension giving a better path (an integer overflow) is ignored
+type Algebra[C Number] struct {
+	Identity C                    // value of the empty path
+	Combine  func(path, edge C) C // value of a path extended by an edge
+	Better   func(a, b C) bool    // true if the value a is strictly better than b
+}
+
+// SumMin finds the shortest path: distances are added, the lowest total is the best
+func SumMin[C Number]() Algebra[C] {
+	return Algebra[C]{
+		Identity: 0,
+		Combine:  func(path, edge C) C { return path + edge },
+		Better:   func(a, b C) bool { return a < b },
+	}
+}
+
+// MaxMin finds the widest path: the capacity of a path is its lowest edge capacity, the highest is the best
+func MaxMin[C Number]() Algebra[C] {
+	return Algebra[C]{
+		Identity: highest[C](),
+		Combine:  func(path, edge C) C { return min(path, edge) },
+		Better:   func(a, b C) bool { return a > b },
+	}
+}
+
+// MaxProduct finds the most reliable path: probabilities (in [0, 1]) are multiplied, the highest is the best
+func MaxProduct[C Number]() Algebra[C] {
+	return Algebra[C]{
+		Identity: 1,
+		Combine:  func(path, edge C) C { return path * edge },
+		Better:   func(a, b C) bool { return a > b },
+	}
+}
+
+// highest returns the highest value of the type (+Inf for floats)
+func highest[C Number]() C {
+	half := 0.5
+	if C(half) != 0 {
+		return C(math.Inf(1)) // float
+	}
+	var zero C
+	if all := zero - 1; all > 0 {
+		return all // unsigned integer
+	}
+	bit := C(1) // signed integer: find the highest bit below the sign bit
+	for bit*2 > bit {
+		bit *= 2
+	}
+	return bit + (bit - 1)
+}
+
+// RunAlgebra finds the best path from start to goal, using the given path algebra
+// * start:     first node of the path
+// * goal:      last node of the path
+// * algebra:   how the values of the edges are combined and compared (SumMin, MaxMin, MaxProduct, ...)
+// * neighbors: list of unordered neighbors of the given node with the value of the edge
+// Returns the path and its value or nil if nothing is found
+func RunAlgebra[T comparable, C Number](start, goal T, algebra Algebra[C], neighbors func(T) map[T]C) ([]T, C) {
+	stop := func(n T) bool { return n == goal }
+	values, prev := settle(start, stop, algebra, neighbors, newHeapQueue[T](algebra.Better))
+	value, ok := values[goal]
+	if !ok {
+		return nil, 0
+	}
+	return build(start, goal, prev), value
+}
+
+// ShortestPathsAlgebra computes the best value from start to every reachable node, using the given path algebra
+// * start:     first node of the paths
+// * algebra:   how the values of the edges are combined and compared (SumMin, MaxMin, MaxProduct, ...)
+// * neighbors: list of unordered neighbors of the given node with the value of the edge
+// Returns the value of each reachable node and its predecessor on the best path
+// (the start node has no predecessor)
+func ShortestPathsAlgebra[T comparable, C Number](start T, algebra Algebra[C], neighbors func(T) map[T]C) (map[T]C, map[T]T) {
+	return settle(start, nil, algebra, neighbors, newHeapQueue[T](algebra.Better))
+}
+
+// settle computes the best value of the nodes reachable from start, until a node satisfies stop (can be nil)
+// This is the core of the Dijkstra searches, the queue gives the node with the best value
+func settle[T comparable, C Number](start T, stop func(T) bool, algebra Algebra[C], neighbors func(T) map[T]C, q queue[T, C]) (map[T]C, map[T]T) {
+	values := make(map[T]C)
+	prev := make(map[T]T)
+	q.push(start, algebra.Identity)
+	tentative := map[T]C{start: algebra.Identity}
+
+	// While the queue is not empty, pop the node with the best value
+	for q.size() > 0 {
+		node, value := q.pop()
+		if _, ok := values[node]; ok {
+			continue
+		}
+		values[node] = value
+		if stop != nil && stop(node) {
+			break
+		}
+
+		// Relax each neighbor not yet settled
+		for n, edge := range neighbors(node) {
+			if _, ok := values[n]; ok {
+				continue
+			}
+			v := algebra.Combine(value, edge)
+			if algebra.Better(v, value) && !algebra.Better(edge, algebra.Identity) {
+				continue // overflow
+			}
+			if old, ok := tentative[n]; ok && !algebra.Better(v, old) {
+				continue
+			}
+			tentative[n] = v
+			prev[n] = node
+			q.push(n, v)
+		}
+	}
+
+	return values, prev
+}
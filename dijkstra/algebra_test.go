@@ -0,0 +1,80 @@
+package dijkstra_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/dijkstra"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAlgebra(t *testing.T) {
+	// a --1--> b --1--> d
+	// |                 ^
+	// +--2--> c ---2----+
+	// value of the edges: distance, capacity, reliability
+	edges := map[string]map[string][3]float64{
+		"a": {"b": {1, 10, 0.9}, "c": {2, 20, 0.99}},
+		"b": {"d": {1, 5, 0.9}},
+		"c": {"d": {2, 15, 0.95}},
+	}
+	neighbors := func(k int) func(string) map[string]float64 {
+		return func(n string) map[string]float64 {
+			res := make(map[string]float64)
+			for m, e := range edges[n] {
+				res[m] = e[k]
+			}
+			return res
+		}
+	}
+
+	Convey("sum-min", t, func() {
+		path, value := dijkstra.RunAlgebra("a", "d", dijkstra.SumMin[float64](), neighbors(0))
+		So(path, ShouldResemble, []string{"a", "b", "d"})
+		So(value, ShouldEqual, 2)
+	})
+
+	Convey("max-min bottleneck", t, func() {
+		path, value := dijkstra.RunAlgebra("a", "d", dijkstra.MaxMin[float64](), neighbors(1))
+		So(path, ShouldResemble, []string{"a", "c", "d"})
+		So(value, ShouldEqual, 15)
+	})
+
+	Convey("max-product reliability", t, func() {
+		path, value := dijkstra.RunAlgebra("a", "d", dijkstra.MaxProduct[float64](), neighbors(2))
+		So(path, ShouldResemble, []string{"a", "c", "d"})
+		So(value, ShouldAlmostEqual, 0.9405)
+
+		values, prev := dijkstra.ShortestPathsAlgebra("a", dijkstra.MaxProduct[float64](), neighbors(2))
+		So(values, ShouldHaveLength, 4)
+		So(values["a"], ShouldEqual, 1)
+		So(values["b"], ShouldEqual, 0.9)
+		So(prev, ShouldResemble, map[string]string{"b": "a", "c": "a", "d": "c"})
+	})
+
+	Convey("when integer values", t, func() {
+		capacities := func(n string) map[string]int8 {
+			return map[string]map[string]int8{"a": {"b": 100}, "b": {"c": 50}}[n]
+		}
+		path, value := dijkstra.RunAlgebra("a", "c", dijkstra.MaxMin[int8](), capacities)
+		So(path, ShouldResemble, []string{"a", "b", "c"})
+		So(value, ShouldEqual, 50)
+
+		_, value = dijkstra.RunAlgebra("a", "a", dijkstra.MaxMin[int8](), capacities)
+		So(value, ShouldEqual, 127)
+		_, highest := dijkstra.RunAlgebra("a", "a", dijkstra.MaxMin[uint16](), func(string) map[string]uint16 { return nil })
+		So(highest, ShouldEqual, 65535)
+	})
+
+	Convey("when no path", t, func() {
+		path, value := dijkstra.RunAlgebra("d", "a", dijkstra.MaxMin[float64](), neighbors(1))
+		So(path, ShouldBeNil)
+		So(value, ShouldEqual, 0)
+	})
+
+	Convey("when start is goal", t, func() {
+		path, value := dijkstra.RunAlgebra("a", "a", dijkstra.MaxMin[float64](), neighbors(1))
+		So(path, ShouldResemble, []string{"a"})
+		So(math.IsInf(value, 1), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,99 @@
+package dijkstra
+
+import "fmt"
+
+var (
+	ErrWeightOutOfRange = fmt.Errorf("edge weight out of range")
+)
+
+// maxBuckets is the highest number of buckets of Dial's algorithm
+const maxBuckets = 1 << 24
+
+// Integer is the type of the distances for Dial's algorithm
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// bucketQueue is a circular list of buckets, one bucket per distance
+// All the distances in the queue are between the current distance and the current distance plus the highest weight
+type bucketQueue[T any, C Integer] struct {
+	buckets [][]T
+	current C   // lowest distance in the queue
+	count   int // number of nodes in the queue
+}
+
+// newBucketQueue builds an empty queue for the given highest weight of the edges
+func newBucketQueue[T any, C Integer](highest C) *bucketQueue[T, C] {
+	return &bucketQueue[T, C]{buckets: make([][]T, int(highest)+1)}
+}
+
+// bucket returns the index of the bucket of the distance
+func (q *bucketQueue[T, C]) bucket(d C) int {
+	return int(uint64(d) % uint64(len(q.buckets)))
+}
+
+// push adds the node with its distance
+func (q *bucketQueue[T, C]) push(n T, d C) {
+	i := q.bucket(d)
+	q.buckets[i] = append(q.buckets[i], n)
+	q.count++
+}
+
+// pop removes and returns a node with the lowest distance
+func (q *bucketQueue[T, C]) pop() (T, C) {
+	for {
+		i := q.bucket(q.current)
+		if b := q.buckets[i]; len(b) > 0 {
+			n := b[len(b)-1]
+			q.buckets[i] = b[:len(b)-1]
+			q.count--
+			return n, q.current
+		}
+		q.current++
+	}
+}
+
+// size returns the number of nodes in the queue
+func (q *bucketQueue[T, C]) size() int {
+	return q.count
+}
+
+// RunDial finds the shortest path from start to goal using Dial's algorithm (Dijkstra with a bucket queue)
+// Faster than a heap for small integer distances (one bucket is allocated per distance between 0 and highest)
+// The highest distance is limited to 2^24 - 1
+// A path whose total overflows the type is ignored
+// * start:     first node of the path
+// * goal:      last node of the path
+// * highest:   highest distance between 2 neighbors
+// * neighbors: list of unordered neighbors of the given node with the distance (between 0 and highest)
+// Returns the path and its total distance, nil if nothing is found, or ErrWeightOutOfRange
+func RunDial[T comparable, C Integer](start, goal T, highest C, neighbors func(T) map[T]C) ([]T, C, error) {
+	if highest < 0 || uint64(highest) >= maxBuckets {
+		return nil, 0, ErrWeightOutOfRange
+	}
+
+	// Check the distances, the search ends as soon as an invalid one is found
+	var err error
+	checked := func(n T) map[T]C {
+		res := neighbors(n)
+		for _, d := range res {
+			if d < 0 || d > highest {
+				err = ErrWeightOutOfRange
+				return nil
+			}
+		}
+		return res
+	}
+	stop := func(n T) bool { return err != nil || n == goal }
+
+	values, prev := settle(start, stop, SumMin[C](), checked, newBucketQueue[T](highest))
+	if err != nil {
+		return nil, 0, err
+	}
+	value, ok := values[goal]
+	if !ok {
+		return nil, 0, nil
+	}
+	return build(start, goal, prev), value, nil
+}
@@ -0,0 +1,172 @@
+package dijkstra_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sbiemont/grapo/dijkstra"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// cell is a position in a grid with integer costs
+type cell struct{ x, y int }
+
+// randomCosts builds the neighbors of a grid with random integer costs between 1 and 9
+func randomCosts(size int, seed int64) func(cell) map[cell]int {
+	rnd := rand.New(rand.NewSource(seed))
+	costs := make([]int, size*size)
+	for i := range costs {
+		costs[i] = 1 + rnd.Intn(9)
+	}
+	return func(c cell) map[cell]int {
+		res := make(map[cell]int)
+		for _, d := range []cell{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+			n := cell{c.x + d.x, c.y + d.y}
+			if n.x >= 0 && n.x < size && n.y >= 0 && n.y < size {
+				res[n] = costs[n.y*size+n.x]
+			}
+		}
+		return res
+	}
+}
+
+func TestRunNumeric(t *testing.T) {
+	Convey("when integer distances", t, func() {
+		neighbors := func(n string) map[string]int {
+			return map[string]map[string]int{
+				"a": {"b": 1, "c": 4},
+				"b": {"c": 2, "d": 6},
+				"c": {"d": 3},
+			}[n]
+		}
+		path, dist := dijkstra.RunNumeric("a", "d", nil, neighbors)
+		So(path, ShouldResemble, []string{"a", "b", "c", "d"})
+		So(dist, ShouldEqual, 6)
+
+		path, dist = dijkstra.RunNumeric("a", "d", func(n string) int { return map[string]int{"c": 10}[n] }, neighbors)
+		So(path, ShouldResemble, []string{"a", "b", "d"})
+		So(dist, ShouldEqual, 7)
+
+		path, dist = dijkstra.RunNumeric("d", "a", nil, neighbors)
+		So(path, ShouldBeNil)
+		So(dist, ShouldEqual, 0)
+	})
+}
+
+func TestRunDial(t *testing.T) {
+	Convey("when same distance as dijkstra", t, func() {
+		for seed := range int64(10) {
+			neighbors := randomCosts(30, seed)
+			start, goal := cell{0, 0}, cell{29, 29}
+
+			expected, expectedDist := dijkstra.RunNumeric(start, goal, nil, neighbors)
+			path, dist, err := dijkstra.RunDial(start, goal, 9, neighbors)
+			So(err, ShouldBeNil)
+			So(dist, ShouldEqual, expectedDist)
+			So(path[0], ShouldEqual, start)
+			So(path[len(path)-1], ShouldEqual, goal)
+
+			// the path has the same distance
+			total := 0
+			for i := 1; i < len(path); i++ {
+				total += neighbors(path[i-1])[path[i]]
+			}
+			So(total, ShouldEqual, dist)
+			So(len(expected), ShouldBeGreaterThan, 0)
+		}
+	})
+
+	Convey("when zero distances", t, func() {
+		neighbors := func(n int) map[int]uint8 {
+			return map[int]map[int]uint8{
+				0: {1: 0, 2: 3},
+				1: {2: 0},
+			}[n]
+		}
+		path, dist, err := dijkstra.RunDial(0, 2, 3, neighbors)
+		So(err, ShouldBeNil)
+		So(path, ShouldResemble, []int{0, 1, 2})
+		So(dist, ShouldEqual, 0)
+	})
+
+	Convey("when highest value of the type", t, func() {
+		neighbors := func(n int) map[int]uint8 {
+			return map[int]map[int]uint8{
+				0: {1: 255, 2: 100},
+				2: {1: 100},
+			}[n]
+		}
+		path, dist, err := dijkstra.RunDial(0, 1, 255, neighbors)
+		So(err, ShouldBeNil)
+		So(path, ShouldResemble, []int{0, 2, 1})
+		So(dist, ShouldEqual, 200)
+	})
+
+	Convey("when overflow", t, func() {
+		// 0 -> 1 -> 2 -> 3 overflows uint8 (600), 0 -> 4 -> 3 does not (250)
+		neighbors := func(n int) map[int]uint8 {
+			return map[int]map[int]uint8{
+				0: {1: 200, 4: 125},
+				1: {2: 200},
+				2: {3: 200},
+				4: {3: 125},
+			}[n]
+		}
+		path, dist, err := dijkstra.RunDial(0, 3, 200, neighbors)
+		So(err, ShouldBeNil)
+		So(path, ShouldResemble, []int{0, 4, 3})
+		So(dist, ShouldEqual, 250)
+
+		path, dist = dijkstra.RunNumeric(0, 3, nil, neighbors)
+		So(path, ShouldResemble, []int{0, 4, 3})
+		So(dist, ShouldEqual, 250)
+
+		// the only path overflows
+		path, _, err = dijkstra.RunDial(0, 2, 200, neighbors)
+		So(err, ShouldBeNil)
+		So(path, ShouldBeNil)
+	})
+
+	Convey("when weight out of range", t, func() {
+		neighbors := func(n int) map[int]int {
+			return map[int]map[int]int{
+				0: {1: 1},
+				1: {2: 10},
+			}[n]
+		}
+		_, _, err := dijkstra.RunDial(0, 2, 9, neighbors)
+		So(err, ShouldBeError, dijkstra.ErrWeightOutOfRange.Error())
+
+		_, _, err = dijkstra.RunDial(0, 2, 10, func(int) map[int]int { return map[int]int{1: -1} })
+		So(err, ShouldBeError, dijkstra.ErrWeightOutOfRange.Error())
+
+		// too many buckets
+		_, _, err = dijkstra.RunDial(0, 2, -1, neighbors)
+		So(err, ShouldBeError, dijkstra.ErrWeightOutOfRange.Error())
+		_, _, err = dijkstra.RunDial(0, 2, math.MaxInt, neighbors)
+		So(err, ShouldBeError, dijkstra.ErrWeightOutOfRange.Error())
+		_, _, err = dijkstra.RunDial(0, 2, uint64(math.MaxUint64), func(int) map[int]uint64 { return nil })
+		So(err, ShouldBeError, dijkstra.ErrWeightOutOfRange.Error())
+	})
+
+	Convey("when no path", t, func() {
+		path, _, err := dijkstra.RunDial(2, 0, 3, func(int) map[int]int { return nil })
+		So(err, ShouldBeNil)
+		So(path, ShouldBeNil)
+	})
+}
+
+func BenchmarkRunNumeric(b *testing.B) {
+	neighbors := randomCosts(100, 1)
+	for range b.N {
+		dijkstra.RunNumeric(cell{0, 0}, cell{99, 99}, nil, neighbors)
+	}
+}
+
+func BenchmarkRunDial(b *testing.B) {
+	neighbors := randomCosts(100, 1)
+	for range b.N {
+		dijkstra.RunDial(cell{0, 0}, cell{99, 99}, 9, neighbors)
+	}
+}
@@ -1,7 +1,7 @@
 package dijkstra
 
 import (
-	"container/heap"
+	"slices"
 )
 
 // Inspired from https://dev.to/douglasmakey/implementation-of-dijkstra-using-heap-in-go-6e3
@@ -12,42 +12,52 @@ import (
 // * neighbors: list of unordered neighbors of the given node with the distance
 // Returns the path or nil if nothing is found
 func Run[T comparable](start, goal T, weight func(T) float64, neighbors func(T) map[T]float64) []T {
-	// Init a new heap with a path containing the start node
-	wqueue := &weightQueue[T]{}
-	heap.Init(wqueue)
-	heap.Push(wqueue, path[T]{
-		nodes:  []T{start},
-		weight: 0,
-	})
-	visited := make(map[T]bool)
-
-	// While the queue is not empty, pop the path with the lowest weight
-	for wqueue.Len() > 0 {
-		p := heap.Pop(wqueue).(path[T]) // path with the lowest weight (and remove it from the heap)
-		node := p.nodes[len(p.nodes)-1] // last node of the path
-		if visited[node] {
-			continue
-		}
-		if node == goal {
-			return p.nodes
-		}
+	p, _ := RunNumeric(start, goal, weight, neighbors)
+	return p
+}
 
-		// For each neighbor of the current node, create a new path with its total weight
-		for n, dist := range neighbors(node) {
-			if !visited[n] {
-				var w float64
-				if weight != nil {
-					w = weight(n)
-				}
-				heap.Push(wqueue, path[T]{ // new path with an increased weight and a new node
-					nodes:  append(p.nodes, n),
-					weight: p.weight + w + dist,
-				})
-			}
-		}
+// RunNumeric finds the shortest path from start to goal with any numeric type of distances
+// Integer distances avoid rounding problems (Run uses the same search)
+// With integer types, a path whose total overflows the type is ignored
+// * start:     first node of the path
+// * goal:      last node of the path
+// * weight:    give the node's weight (can be nil to give all nodes a 0 weight)
+// * neighbors: list of unordered neighbors of the given node with the distance
+// Returns the path and its total weight, or nil if nothing is found
+func RunNumeric[T comparable, C Number](start, goal T, weight func(T) C, neighbors func(T) map[T]C) ([]T, C) {
+	return RunAlgebra(start, goal, SumMin[C](), weighted(weight, neighbors))
+}
 
-		visited[node] = true
+// build builds the path from start to goal, following the predecessors back from the goal
+func build[T comparable](start, goal T, prev map[T]T) []T {
+	path := []T{goal}
+	for n := goal; n != start; n = prev[n] {
+		path = append(path, prev[n])
 	}
+	slices.Reverse(path)
+	return path
+}
 
-	return nil
+// ShortestPaths computes the shortest distance from start to every reachable node
+// * start:     first node of the paths
+// * weight:    give the node's weight (can be nil to give all nodes a 0 weight)
+// * neighbors: list of unordered neighbors of the given node with the distance
+// Returns the distance of each reachable node and its predecessor on the shortest path
+// (the start node has no predecessor)
+func ShortestPaths[T comparable](start T, weight func(T) float64, neighbors func(T) map[T]float64) (map[T]float64, map[T]T) {
+	return ShortestPathsAlgebra(start, SumMin[float64](), weighted(weight, neighbors))
+}
+
+// weighted adds the weight of the reached node to the distance of each neighbor
+func weighted[T comparable, C Number](weight func(T) C, neighbors func(T) map[T]C) func(T) map[T]C {
+	if weight == nil {
+		return neighbors
+	}
+	return func(n T) map[T]C {
+		res := make(map[T]C)
+		for m, dist := range neighbors(n) {
+			res[m] = dist + weight(m)
+		}
+		return res
+	}
 }
@@ -65,6 +65,23 @@ func TestDijkstra(t *testing.T) {
 		So(path, ShouldResemble, []*node{nodeA, nodeC, nodeD, nodeF})
 	})
 
+	Convey("when negative weights", t, func() {
+		nodeA := &node{id: "a", weight: -0.5}
+		nodeB := &node{id: "b", weight: -0.5}
+		nodeC := &node{id: "c", weight: -0.5}
+
+		nodeA.neighbors = map[*node]float64{nodeB: -1}
+		nodeB.neighbors = map[*node]float64{nodeC: 2}
+
+		// Negative edge
+		So(dijkstra.Run(nodeA, nodeC, nil, neighbors), ShouldResemble, []*node{nodeA, nodeB, nodeC})
+
+		// Negative node weight
+		nodeA.neighbors = map[*node]float64{nodeB: 0}
+		nodeB.neighbors = map[*node]float64{nodeC: 0}
+		So(dijkstra.Run(nodeA, nodeC, weight, neighbors), ShouldResemble, []*node{nodeA, nodeB, nodeC})
+	})
+
 	Convey("when no path", t, func() {
 		nodeA := &node{id: "a"}
 		nodeB := &node{id: "b"}
@@ -81,3 +98,41 @@ func TestDijkstra(t *testing.T) {
 		So(path, ShouldBeNil)
 	})
 }
+
+func TestShortestPaths(t *testing.T) {
+	type node struct {
+		id        string
+		weight    float64
+		neighbors map[*node]float64
+	}
+
+	weight := func(a *node) float64 {
+		return a.weight
+	}
+
+	neighbors := func(a *node) map[*node]float64 {
+		return a.neighbors
+	}
+
+	Convey("when ok", t, func() {
+		// Create nodes
+		nodeA := &node{id: "a"}
+		nodeB := &node{id: "b", weight: 1}
+		nodeC := &node{id: "c"}
+		nodeD := &node{id: "d"}
+		nodeE := &node{id: "e"} // not reachable
+
+		// Create edges
+		nodeA.neighbors = map[*node]float64{nodeB: 1, nodeC: 4}
+		nodeB.neighbors = map[*node]float64{nodeC: 1, nodeD: 5}
+		nodeC.neighbors = map[*node]float64{nodeD: 1}
+		nodeE.neighbors = map[*node]float64{nodeA: 1}
+
+		// Test algorithm
+		dist, prev := dijkstra.ShortestPaths(nodeA, weight, neighbors)
+
+		// Check distances and predecessors
+		So(dist, ShouldResemble, map[*node]float64{nodeA: 0, nodeB: 2, nodeC: 3, nodeD: 4})
+		So(prev, ShouldResemble, map[*node]*node{nodeB: nodeA, nodeC: nodeB, nodeD: nodeC})
+	})
+}
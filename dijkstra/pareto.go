@@ -0,0 +1,130 @@
+package dijkstra
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Route is a path with its cost for each criterion
+type Route[T any] struct {
+	Path  []T
+	Costs []float64
+}
+
+// vector is a partial path reaching a node, with its cost for each criterion
+type vector[T any] struct {
+	node      T
+	costs     []float64
+	parent    *vector[T]
+	dominated bool // true when a better partial path reaches the same node
+}
+
+// dominates checks if the costs are lower or equal for all criteria
+func (v *vector[T]) dominates(other *vector[T]) bool {
+	for i, c := range v.costs {
+		if c > other.costs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// path builds the list of nodes from the start to the partial path
+func (v *vector[T]) path() []T {
+	var nodes []T
+	for ; v != nil; v = v.parent {
+		nodes = append(nodes, v.node)
+	}
+	slices.Reverse(nodes)
+	return nodes
+}
+
+// vectorQueue is a list of partial paths in lexicographic order of their costs
+// Implement heap.Interface for vectorQueue[T]
+type vectorQueue[T any] []*vector[T]
+
+func (q vectorQueue[T]) Len() int           { return len(q) }
+func (q vectorQueue[T]) Less(i, j int) bool { return slices.Compare(q[i].costs, q[j].costs) < 0 }
+func (q vectorQueue[T]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *vectorQueue[T]) Push(x any)        { *q = append(*q, x.(*vector[T])) }
+
+func (q *vectorQueue[T]) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*q = old[0 : n-1]
+	return x
+}
+
+// Pareto finds all non dominated paths from start to goal (Martins' algorithm)
+// A path is dominated when another one is better or equal for all criteria
+// * start:     first node of the paths
+// * goal:      last node of the paths
+// * criteria:  number of criteria
+// * neighbors: list of unordered neighbors of the given node with the cost of the move for each criterion (non negative)
+// Returns the Pareto front, in lexicographic order of the costs (empty if nothing is found)
+func Pareto[T comparable](start, goal T, criteria int, neighbors func(T) map[T][]float64) []Route[T] {
+	return martins(start, goal, criteria, neighbors, false)
+}
+
+// Lexicographic finds the best path from start to goal, comparing the criteria in order
+// * start:     first node of the path
+// * goal:      last node of the path
+// * criteria:  number of criteria, by decreasing priority
+// * neighbors: list of unordered neighbors of the given node with the cost of the move for each criterion (non negative)
+// Returns the path and its cost for each criterion, or nil if nothing is found
+func Lexicographic[T comparable](start, goal T, criteria int, neighbors func(T) map[T][]float64) ([]T, []float64) {
+	routes := martins(start, goal, criteria, neighbors, true)
+	if len(routes) == 0 {
+		return nil, nil
+	}
+	return routes[0].Path, routes[0].Costs
+}
+
+// martins performs the label-setting search of the non dominated paths
+// * first: stop at the first path found (the lexicographic best one)
+func martins[T comparable](start, goal T, criteria int, neighbors func(T) map[T][]float64, first bool) []Route[T] {
+	origin := &vector[T]{node: start, costs: make([]float64, criteria)}
+	labels := map[T][]*vector[T]{start: {origin}} // non dominated partial paths of each node
+	vqueue := &vectorQueue[T]{}
+	heap.Init(vqueue)
+	heap.Push(vqueue, origin)
+
+	// While the queue is not empty, pop the partial path with the lowest costs (lexicographic order)
+	var routes []Route[T]
+	for vqueue.Len() > 0 {
+		v := heap.Pop(vqueue).(*vector[T])
+		if v.dominated {
+			continue
+		}
+		if v.node == goal {
+			routes = append(routes, Route[T]{Path: v.path(), Costs: v.costs})
+			if first {
+				break
+			}
+			continue
+		}
+
+		// Extend the partial path to each neighbor
+		for n, costs := range neighbors(v.node) {
+			next := &vector[T]{node: n, costs: slices.Clone(v.costs), parent: v}
+			for i, c := range costs {
+				next.costs[i] += c
+			}
+
+			// Dominance pruning, also by the paths already found
+			dominated := func(other *vector[T]) bool { return other.dominates(next) }
+			if slices.ContainsFunc(labels[n], dominated) || slices.ContainsFunc(labels[goal], dominated) {
+				continue
+			}
+			labels[n] = slices.DeleteFunc(labels[n], func(other *vector[T]) bool {
+				other.dominated = next.dominates(other)
+				return other.dominated
+			})
+			labels[n] = append(labels[n], next)
+			heap.Push(vqueue, next)
+		}
+	}
+	return routes
+}
@@ -0,0 +1,111 @@
+package dijkstra_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/sbiemont/grapo/dijkstra"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPareto(t *testing.T) {
+	// costs: time, price
+	arcs := map[string]map[string][]float64{
+		"a": {
+			"b": {1, 5},
+			"c": {3, 1},
+			"d": {4, 6},
+			"e": {1, 4},
+		},
+		"b": {"d": {1, 5}},
+		"c": {"d": {3, 1}},
+		"e": {"d": {4, 4}},
+	}
+	neighbors := func(n string) map[string][]float64 { return arcs[n] }
+
+	Convey("pareto front", t, func() {
+		So(dijkstra.Pareto("a", "d", 2, neighbors), ShouldResemble, []dijkstra.Route[string]{
+			{Path: []string{"a", "b", "d"}, Costs: []float64{2, 10}},
+			{Path: []string{"a", "d"}, Costs: []float64{4, 6}},
+			{Path: []string{"a", "c", "d"}, Costs: []float64{6, 2}},
+		}) // a-e-d is dominated by a-d
+	})
+
+	Convey("lexicographic", t, func() {
+		path, costs := dijkstra.Lexicographic("a", "d", 2, neighbors)
+		So(path, ShouldResemble, []string{"a", "b", "d"})
+		So(costs, ShouldResemble, []float64{2, 10})
+
+		// Price first
+		swapped := func(n string) map[string][]float64 {
+			res := make(map[string][]float64)
+			for m, c := range arcs[n] {
+				res[m] = []float64{c[1], c[0]}
+			}
+			return res
+		}
+		path, costs = dijkstra.Lexicographic("a", "d", 2, swapped)
+		So(path, ShouldResemble, []string{"a", "c", "d"})
+		So(costs, ShouldResemble, []float64{2, 6})
+	})
+
+	Convey("when no path", t, func() {
+		So(dijkstra.Pareto("d", "a", 2, neighbors), ShouldBeEmpty)
+		path, costs := dijkstra.Lexicographic("d", "a", 2, neighbors)
+		So(path, ShouldBeNil)
+		So(costs, ShouldBeNil)
+	})
+
+	Convey("when random graphs", t, func() {
+		// Compare with the non dominated simple paths
+		rnd := rand.New(rand.NewSource(42))
+		for range 50 {
+			const size = 7
+			graph := make(map[int]map[int][]float64)
+			for i := range size {
+				graph[i] = make(map[int][]float64)
+				for j := range size {
+					if i != j && rnd.Float64() < 0.5 {
+						graph[i][j] = []float64{float64(rnd.Intn(10)), float64(rnd.Intn(10)), float64(rnd.Intn(10))}
+					}
+				}
+			}
+
+			var all [][]float64
+			visited := map[int]bool{}
+			var explore func(n int, costs []float64)
+			explore = func(n int, costs []float64) {
+				if n == size-1 {
+					all = append(all, costs)
+					return
+				}
+				visited[n] = true
+				for m, c := range graph[n] {
+					if !visited[m] {
+						explore(m, []float64{costs[0] + c[0], costs[1] + c[1], costs[2] + c[2]})
+					}
+				}
+				visited[n] = false
+			}
+			explore(0, []float64{0, 0, 0})
+
+			var expected [][]float64
+			for _, c := range all {
+				dominated := slices.ContainsFunc(all, func(o []float64) bool {
+					return o[0] <= c[0] && o[1] <= c[1] && o[2] <= c[2] && !slices.Equal(o, c)
+				})
+				if !dominated && !slices.ContainsFunc(expected, func(o []float64) bool { return slices.Equal(o, c) }) {
+					expected = append(expected, c)
+				}
+			}
+			slices.SortFunc(expected, slices.Compare)
+
+			var found [][]float64
+			for _, r := range dijkstra.Pareto(0, size-1, 3, func(n int) map[int][]float64 { return graph[n] }) {
+				found = append(found, r.Costs)
+			}
+			So(found, ShouldResemble, expected)
+		}
+	})
+}
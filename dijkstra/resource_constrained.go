@@ -0,0 +1,147 @@
+package dijkstra
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrInvalidResources = fmt.Errorf("invalid number of resources")
+)
+
+// Arc is a move to a neighbor, with its cost and its consumption of each resource
+type Arc struct {
+	Cost      float64
+	Resources []float64 // consumption of each resource (non negative)
+}
+
+// label is a partial path reaching a node
+type label[T any] struct {
+	node      T
+	cost      float64   // total cost of the partial path
+	resources []float64 // total consumption of each resource
+	parent    *label[T] // previous label of the partial path
+	dominated bool      // true when a better label reaches the same node
+}
+
+// dominates checks if the label is at least as good as the other one, for the cost and all resources
+func (l *label[T]) dominates(other *label[T]) bool {
+	if l.cost > other.cost {
+		return false
+	}
+	for i, r := range l.resources {
+		if r > other.resources[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// labelQueue is a list of labels ordered by total cost
+// Implement heap.Interface for labelQueue[T]
+type labelQueue[T any] []*label[T]
+
+func (q labelQueue[T]) Len() int           { return len(q) }
+func (q labelQueue[T]) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q labelQueue[T]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *labelQueue[T]) Push(x any)        { *q = append(*q, x.(*label[T])) }
+
+func (q *labelQueue[T]) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*q = old[0 : n-1]
+	return x
+}
+
+// ResourceConstrained finds the lowest cost path from start to goal, using at most the available resources
+// It uses a label-setting algorithm: each node keeps its non dominated partial paths (labels),
+// a label is dominated when another one has a lower or equal cost and lower or equal consumptions
+// * start:     first node of the path
+// * goal:      last node of the path
+// * resources: number of resources
+// * limits:    give the maximum total consumption of each resource when reaching the node (can be nil for no limits)
+// * neighbors: list of unordered neighbors of the given node with the cost and consumptions of the move
+// Returns the path, its cost and its total consumption of each resource, nil if nothing is found,
+// or ErrInvalidResources if an arc or a limit has more values than resources
+func ResourceConstrained[T comparable](start, goal T, resources int, limits func(T) []float64, neighbors func(T) map[T]Arc) ([]T, float64, []float64, error) {
+	first := &label[T]{node: start, resources: make([]float64, resources)}
+	if ok, err := feasible(first.resources, limits, start); !ok {
+		return nil, 0, nil, err
+	}
+	labels := map[T][]*label[T]{start: {first}} // non dominated labels of each node
+	lqueue := &labelQueue[T]{}
+	heap.Init(lqueue)
+	heap.Push(lqueue, first)
+
+	// While the queue is not empty, pop the label with the lowest cost
+	for lqueue.Len() > 0 {
+		l := heap.Pop(lqueue).(*label[T])
+		if l.dominated {
+			continue
+		}
+		if l.node == goal {
+			return l.path(), l.cost, l.resources, nil
+		}
+
+		// Extend the label to each neighbor
+		for n, arc := range neighbors(l.node) {
+			if len(arc.Resources) > resources {
+				return nil, 0, nil, ErrInvalidResources
+			}
+			next := &label[T]{node: n, cost: l.cost + arc.Cost, resources: slices.Clone(l.resources), parent: l}
+			for i, r := range arc.Resources {
+				next.resources[i] += r
+			}
+			ok, err := feasible(next.resources, limits, n)
+			if err != nil {
+				return nil, 0, nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			// Dominance pruning
+			if slices.ContainsFunc(labels[n], func(other *label[T]) bool { return other.dominates(next) }) {
+				continue
+			}
+			labels[n] = slices.DeleteFunc(labels[n], func(other *label[T]) bool {
+				other.dominated = next.dominates(other)
+				return other.dominated
+			})
+			labels[n] = append(labels[n], next)
+			heap.Push(lqueue, next)
+		}
+	}
+	return nil, 0, nil, nil
+}
+
+// feasible checks if the consumptions are within the limits of the node
+// Returns ErrInvalidResources if there are more limits than resources
+func feasible[T any](resources []float64, limits func(T) []float64, n T) (bool, error) {
+	if limits == nil {
+		return true, nil
+	}
+	l := limits(n)
+	if len(l) > len(resources) {
+		return false, ErrInvalidResources
+	}
+	for i, limit := range l {
+		if resources[i] > limit {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// path builds the list of nodes from the start to the label
+func (l *label[T]) path() []T {
+	var nodes []T
+	for ; l != nil; l = l.parent {
+		nodes = append(nodes, l.node)
+	}
+	slices.Reverse(nodes)
+	return nodes
+}
@@ -0,0 +1,124 @@
+package dijkstra_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sbiemont/grapo/dijkstra"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResourceConstrained(t *testing.T) {
+	//     +-- b --+
+	//     |       |
+	// a --+-- c --+-- e
+	//     |       |
+	//     +-- d --+
+	// via b: cheap and slow, via c: medium, via d: expensive and fast
+	arcs := map[string]map[string]dijkstra.Arc{
+		"a": {
+			"b": {Cost: 1, Resources: []float64{10, 1}},
+			"c": {Cost: 3, Resources: []float64{5, 1}},
+			"d": {Cost: 5, Resources: []float64{1, 5}},
+		},
+		"b": {"e": {Cost: 1, Resources: []float64{10, 1}}},
+		"c": {"e": {Cost: 3, Resources: []float64{5, 1}}},
+		"d": {"e": {Cost: 5, Resources: []float64{1, 5}}},
+	}
+	neighbors := func(n string) map[string]dijkstra.Arc { return arcs[n] }
+	budget := func(time, battery float64) func(string) []float64 {
+		return func(string) []float64 { return []float64{time, battery} }
+	}
+
+	Convey("when no limits", t, func() {
+		path, cost, resources, err := dijkstra.ResourceConstrained("a", "e", 2, nil, neighbors)
+		So(err, ShouldBeNil)
+		So(path, ShouldResemble, []string{"a", "b", "e"})
+		So(cost, ShouldEqual, 2)
+		So(resources, ShouldResemble, []float64{20, 2})
+	})
+
+	Convey("when time budget", t, func() {
+		path, cost, _, _ := dijkstra.ResourceConstrained("a", "e", 2, budget(15, 100), neighbors)
+		So(path, ShouldResemble, []string{"a", "c", "e"})
+		So(cost, ShouldEqual, 6)
+
+		path, cost, _, _ = dijkstra.ResourceConstrained("a", "e", 2, budget(5, 100), neighbors)
+		So(path, ShouldResemble, []string{"a", "d", "e"})
+		So(cost, ShouldEqual, 10)
+	})
+
+	Convey("when per-node limits", t, func() {
+		// the battery is low when reaching d
+		limits := func(n string) []float64 {
+			if n == "d" {
+				return []float64{math.Inf(1), 4}
+			}
+			return []float64{5, 100}
+		}
+		path, _, _, err := dijkstra.ResourceConstrained("a", "e", 2, limits, neighbors)
+		So(err, ShouldBeNil)
+		So(path, ShouldBeNil)
+	})
+
+	Convey("when invalid number of resources", t, func() {
+		_, _, _, err := dijkstra.ResourceConstrained("a", "e", 1, nil, neighbors)
+		So(err, ShouldBeError, dijkstra.ErrInvalidResources.Error())
+
+		_, _, _, err = dijkstra.ResourceConstrained("a", "e", 2, budget(15, 100), func(string) map[string]dijkstra.Arc {
+			return map[string]dijkstra.Arc{"e": {Cost: 1, Resources: []float64{1, 1, 1}}}
+		})
+		So(err, ShouldBeError, dijkstra.ErrInvalidResources.Error())
+
+		_, _, _, err = dijkstra.ResourceConstrained("a", "e", 1, budget(15, 100), neighbors)
+		So(err, ShouldBeError, dijkstra.ErrInvalidResources.Error())
+	})
+
+	Convey("when random graphs", t, func() {
+		// Compare with all simple paths
+		rnd := rand.New(rand.NewSource(42))
+		for range 50 {
+			const size = 8
+			graph := make(map[int]map[int]dijkstra.Arc)
+			for i := range size {
+				graph[i] = make(map[int]dijkstra.Arc)
+				for j := range size {
+					if i != j && rnd.Float64() < 0.4 {
+						graph[i][j] = dijkstra.Arc{Cost: float64(rnd.Intn(10)), Resources: []float64{float64(rnd.Intn(10))}}
+					}
+				}
+			}
+			limits := func(int) []float64 { return []float64{15} }
+
+			best := math.Inf(1)
+			visited := map[int]bool{}
+			var explore func(n int, cost, resource float64)
+			explore = func(n int, cost, resource float64) {
+				if resource > 15 {
+					return
+				}
+				if n == size-1 {
+					best = min(best, cost)
+					return
+				}
+				visited[n] = true
+				for m, arc := range graph[n] {
+					if !visited[m] {
+						explore(m, cost+arc.Cost, resource+arc.Resources[0])
+					}
+				}
+				visited[n] = false
+			}
+			explore(0, 0, 0)
+
+			path, cost, resources, _ := dijkstra.ResourceConstrained(0, size-1, 1, limits, func(n int) map[int]dijkstra.Arc { return graph[n] })
+			if math.IsInf(best, 1) {
+				So(path, ShouldBeNil)
+				continue
+			}
+			So(cost, ShouldEqual, best)
+			So(resources[0], ShouldBeLessThanOrEqualTo, 15)
+		}
+	})
+}
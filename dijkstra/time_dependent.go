@@ -0,0 +1,91 @@
+package dijkstra
+
+import (
+	"math"
+	"slices"
+)
+
+// Hop is a node of a timed path
+type Hop[T any] struct {
+	Node      T
+	Arrival   float64 // arrival time at the node
+	Departure float64 // departure time from the node (later than the arrival when waiting for the window to open)
+}
+
+// RunTimeDependent finds the earliest arrival path from start to goal, when travel times depend on the departure time
+// Travel times shall be FIFO: leaving later never makes arriving earlier
+// * start:     first node of the path
+// * goal:      last node of the path
+// * departure: departure time from the start node
+// * travel:    give the travel time from a node to one of its neighbors, leaving at the given time (+Inf if no connection)
+// * window:    give the opening and closing times of the node (can be nil for no time windows)
+// * neighbors: list of unordered neighbors of the given node
+// The travel time is called with the arrival time at the node, after waiting for its window to open
+// Arriving before the opening of a node, the path waits on it; arriving after its closing, the node cannot be used
+// Returns the path with the arrival time at each hop or nil if nothing is found
+func RunTimeDependent[T comparable](start, goal T, departure float64, travel func(from, to T, t float64) float64, window func(T) (open, close float64), neighbors func(T) []T) []Hop[T] {
+	// Waiting for the window to open, or -Inf if the window is closed
+	leave := func(n T, t float64) float64 {
+		if window == nil {
+			return t
+		}
+		open, close := window(n)
+		if t > close {
+			return math.Inf(-1)
+		}
+		return max(t, open)
+	}
+	if math.IsInf(leave(start, departure), -1) {
+		return nil
+	}
+
+	arrival := make(map[T]float64) // earliest arrival of the settled nodes
+	prev := make(map[T]T)
+	tentative := map[T]float64{start: departure}
+	q := newHeapQueue[T](SumMin[float64]().Better)
+	q.push(start, departure)
+
+	// While the queue is not empty, pop the node with the earliest arrival
+	for q.size() > 0 {
+		node, reached := q.pop()
+		if _, ok := arrival[node]; ok {
+			continue
+		}
+		arrival[node] = reached
+		if node == goal {
+			break
+		}
+
+		// Relax each neighbor not yet settled, leaving as soon as possible (FIFO)
+		t := leave(node, reached)
+		for _, n := range neighbors(node) {
+			if _, ok := arrival[n]; ok {
+				continue
+			}
+			next := t + travel(node, n, t)
+			if math.IsInf(next, 1) || math.IsInf(leave(n, next), -1) {
+				continue // no connection or window closed
+			}
+			if old, ok := tentative[n]; ok && old <= next {
+				continue
+			}
+			tentative[n] = next
+			prev[n] = node
+			q.push(n, next)
+		}
+	}
+	if _, ok := arrival[goal]; !ok {
+		return nil
+	}
+
+	// Build the path from the goal back to the start
+	var hops []Hop[T]
+	for n := goal; ; n = prev[n] {
+		hops = append(hops, Hop[T]{Node: n, Arrival: arrival[n], Departure: leave(n, arrival[n])})
+		if n == start {
+			break
+		}
+	}
+	slices.Reverse(hops)
+	return hops
+}
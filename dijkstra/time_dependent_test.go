@@ -0,0 +1,92 @@
+package dijkstra_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/dijkstra"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRunTimeDependent(t *testing.T) {
+	// a --> b --> d
+	// |           ^
+	// +---> c ----+
+	neighbors := func(n string) []string {
+		return map[string][]string{
+			"a": {"b", "c"},
+			"b": {"d"},
+			"c": {"d"},
+		}[n]
+	}
+
+	// a -> b is congested before time 10
+	travel := func(from, to string, t float64) float64 {
+		switch from + to {
+		case "ab":
+			if t < 10 {
+				return 20 - t // FIFO: leaving later never arrives earlier
+			}
+			return 10
+		case "bd":
+			return 5
+		case "ac":
+			return 8
+		case "cd":
+			return 10
+		}
+		return math.Inf(1)
+	}
+
+	Convey("when congested", t, func() {
+		hops := dijkstra.RunTimeDependent("a", "d", 0, travel, nil, neighbors)
+		So(hops, ShouldResemble, []dijkstra.Hop[string]{
+			{Node: "a", Arrival: 0, Departure: 0},
+			{Node: "c", Arrival: 8, Departure: 8},
+			{Node: "d", Arrival: 18, Departure: 18},
+		})
+	})
+
+	Convey("when not congested", t, func() {
+		hops := dijkstra.RunTimeDependent("a", "d", 10, travel, nil, neighbors)
+		So(hops, ShouldResemble, []dijkstra.Hop[string]{
+			{Node: "a", Arrival: 10, Departure: 10},
+			{Node: "b", Arrival: 20, Departure: 20},
+			{Node: "d", Arrival: 25, Departure: 25},
+		})
+	})
+
+	Convey("when time windows", t, func() {
+		windows := map[string][2]float64{
+			"c": {0, 5}, // closed when reached
+			"b": {25, 100},
+		}
+		window := func(n string) (float64, float64) {
+			if w, ok := windows[n]; ok {
+				return w[0], w[1]
+			}
+			return math.Inf(-1), math.Inf(1)
+		}
+
+		// wait on b
+		hops := dijkstra.RunTimeDependent("a", "d", 0, travel, window, neighbors)
+		So(hops, ShouldResemble, []dijkstra.Hop[string]{
+			{Node: "a", Arrival: 0, Departure: 0},
+			{Node: "b", Arrival: 20, Departure: 25},
+			{Node: "d", Arrival: 30, Departure: 30},
+		})
+
+		// b is closed too
+		windows["b"] = [2]float64{0, 15}
+		So(dijkstra.RunTimeDependent("a", "d", 0, travel, window, neighbors), ShouldBeNil)
+
+		// start is closed
+		windows["a"] = [2]float64{10, 20}
+		So(dijkstra.RunTimeDependent("a", "d", 30, travel, window, neighbors), ShouldBeNil)
+	})
+
+	Convey("when start is goal", t, func() {
+		hops := dijkstra.RunTimeDependent("a", "a", 3, travel, nil, neighbors)
+		So(hops, ShouldResemble, []dijkstra.Hop[string]{{Node: "a", Arrival: 3, Departure: 3}})
+	})
+}
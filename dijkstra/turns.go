@@ -0,0 +1,57 @@
+package dijkstra
+
+import (
+	"math"
+	"slices"
+)
+
+// move is a state of the edge-based search: the current node and the node it was reached from
+type move[T comparable] struct {
+	prev    T
+	current T
+	first   bool // true for the start node (no previous node)
+}
+
+// RunTurns finds the shortest path from start to goal, with turn restrictions and turn costs
+// The search is edge-based: a node can be visited several times, reached from different previous nodes
+// * start:     first node of the path
+// * goal:      last node of the path
+// * turn:      give the extra cost of a turn prev -> current -> next (+Inf to forbid it, can be nil)
+// * neighbors: list of unordered neighbors of the given node with the distance
+// The turn cost is not called when leaving the start node
+// Returns the path and its total cost (distances and turns), or nil if nothing is found
+func RunTurns[T comparable](start, goal T, turn func(prev, current, next T) float64, neighbors func(T) map[T]float64) ([]T, float64) {
+	// Moves to each neighbor, with the cost of the turn
+	moves := func(m move[T]) map[move[T]]float64 {
+		res := make(map[move[T]]float64)
+		for n, dist := range neighbors(m.current) {
+			if !m.first && turn != nil {
+				dist += turn(m.prev, m.current, n)
+			}
+			if !math.IsInf(dist, 1) {
+				res[move[T]{prev: m.current, current: n}] = dist
+			}
+		}
+		return res
+	}
+
+	first := move[T]{current: start, first: true}
+	var last move[T]
+	found := false
+	stop := func(m move[T]) bool {
+		last, found = m, m.current == goal
+		return found
+	}
+	costs, prev := settle(first, stop, SumMin[float64](), moves, newHeapQueue[move[T]](SumMin[float64]().Better))
+	if !found {
+		return nil, 0
+	}
+
+	// Build the path from the goal back to the start
+	path := []T{goal}
+	for m := last; !m.first; m = prev[m] {
+		path = append(path, m.prev)
+	}
+	slices.Reverse(path)
+	return path, costs[last]
+}
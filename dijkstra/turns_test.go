@@ -0,0 +1,90 @@
+package dijkstra_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/dijkstra"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRunTurns(t *testing.T) {
+	// point is a crossroad (y goes down)
+	type point struct{ x, y int }
+
+	// streets builds the neighbors of two-way streets
+	streets := func(lengths map[[2]point]float64) func(point) map[point]float64 {
+		return func(p point) map[point]float64 {
+			res := make(map[point]float64)
+			for s, l := range lengths {
+				switch p {
+				case s[0]:
+					res[s[1]] = l
+				case s[1]:
+					res[s[0]] = l
+				}
+			}
+			return res
+		}
+	}
+
+	// isLeft checks if the turn is a left turn
+	isLeft := func(prev, current, next point) bool {
+		return (current.x-prev.x)*(next.y-current.y)-(current.y-prev.y)*(next.x-current.x) < 0
+	}
+	isUTurn := func(prev, _, next point) bool { return prev == next }
+
+	// (0,0) --1-- (1,0)
+	//   |           |
+	//  10           1
+	//   |           |
+	// (0,1) --1-- (1,1) --1-- (2,1)
+	neighbors := streets(map[[2]point]float64{
+		{{0, 0}, {1, 0}}: 1,
+		{{0, 0}, {0, 1}}: 10,
+		{{1, 0}, {1, 1}}: 1,
+		{{0, 1}, {1, 1}}: 1,
+		{{1, 1}, {2, 1}}: 1,
+	})
+	start, goal := point{0, 1}, point{1, 0}
+
+	Convey("when no turn restrictions", t, func() {
+		path, cost := dijkstra.RunTurns(start, goal, nil, neighbors)
+		So(path, ShouldResemble, []point{{0, 1}, {1, 1}, {1, 0}})
+		So(cost, ShouldEqual, 2)
+	})
+
+	Convey("when left turn penalty", t, func() {
+		turn := func(prev, current, next point) float64 {
+			if isLeft(prev, current, next) {
+				return 3
+			}
+			return 0
+		}
+
+		// a U-turn avoids the left turn
+		path, cost := dijkstra.RunTurns(start, goal, turn, neighbors)
+		So(path, ShouldResemble, []point{{0, 1}, {1, 1}, {2, 1}, {1, 1}, {1, 0}})
+		So(cost, ShouldEqual, 4)
+	})
+
+	Convey("when left turn and U-turn are forbidden", t, func() {
+		turn := func(prev, current, next point) float64 {
+			if isLeft(prev, current, next) || isUTurn(prev, current, next) {
+				return math.Inf(1)
+			}
+			return 0
+		}
+
+		// the long way
+		path, cost := dijkstra.RunTurns(start, goal, turn, neighbors)
+		So(path, ShouldResemble, []point{{0, 1}, {0, 0}, {1, 0}})
+		So(cost, ShouldEqual, 11)
+	})
+
+	Convey("when all turns are forbidden", t, func() {
+		turn := func(point, point, point) float64 { return math.Inf(1) }
+		path, _ := dijkstra.RunTurns(start, goal, turn, neighbors)
+		So(path, ShouldBeNil)
+	})
+}
@@ -1,23 +1,55 @@
 package dijkstra
 
-type path[T any] struct {
-	weight float64 // total weight of the path
-	nodes  []T     // list of nodes in the path
+import "container/heap"
+
+// Number is the type of the distances: integers or floats
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// queue gives the reached node with the best value
+type queue[T any, C Number] interface {
+	push(n T, value C)
+	pop() (T, C)
+	size() int
 }
 
-// weightQueue is a list of path ordered by total weight (node weight + distance)
-// Implement heap.Interface for weightQueue[T]
-type weightQueue[T any] []path[T]
+// distance is a node reached with its total weight from the start node
+type distance[T any, C Number] struct {
+	weight C // total weight from the start node
+	node   T // reached node
+}
 
-func (q weightQueue[T]) Len() int           { return len(q) }
-func (q weightQueue[T]) Less(i, j int) bool { return q[i].weight < q[j].weight }
-func (q weightQueue[T]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
-func (q *weightQueue[T]) Push(x any)        { *q = append(*q, x.(path[T])) }
+// heapQueue is a list of reached nodes ordered by total weight, the best first
+// Implement heap.Interface for heapQueue[T, C]
+type heapQueue[T any, C Number] struct {
+	items  []distance[T, C]
+	better func(a, b C) bool
+}
 
-func (q *weightQueue[T]) Pop() any {
-	old := *q
-	n := len(old)
-	x := old[n-1]
-	*q = old[0 : n-1]
+// newHeapQueue builds an empty queue, ordered by the given comparison
+func newHeapQueue[T any, C Number](better func(a, b C) bool) *heapQueue[T, C] {
+	return &heapQueue[T, C]{better: better}
+}
+
+func (q heapQueue[T, C]) Len() int           { return len(q.items) }
+func (q heapQueue[T, C]) Less(i, j int) bool { return q.better(q.items[i].weight, q.items[j].weight) }
+func (q heapQueue[T, C]) Swap(i, j int)      { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *heapQueue[T, C]) Push(x any)        { q.items = append(q.items, x.(distance[T, C])) }
+
+func (q *heapQueue[T, C]) Pop() any {
+	n := len(q.items)
+	x := q.items[n-1]
+	q.items = q.items[0 : n-1]
 	return x
 }
+
+func (q *heapQueue[T, C]) push(n T, value C) { heap.Push(q, distance[T, C]{node: n, weight: value}) }
+func (q *heapQueue[T, C]) size() int         { return q.Len() }
+
+func (q *heapQueue[T, C]) pop() (T, C) {
+	d := heap.Pop(q).(distance[T, C])
+	return d.node, d.weight
+}
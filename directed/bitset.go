@@ -0,0 +1,26 @@
+package directed
+
+// bitset is a compact set of node indexes
+type bitset []uint64
+
+// newBitset creates an empty set able to store indexes up to size-1
+func newBitset(size int) bitset {
+	return make(bitset, (size+63)/64)
+}
+
+// set adds the index in the set
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+// has checks if the index is in the set
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// or adds all indexes of the other set in the set
+func (b bitset) or(other bitset) {
+	for k := range b {
+		b[k] |= other[k]
+	}
+}
@@ -0,0 +1,83 @@
+package directed
+
+import "slices"
+
+// Task is the schedule of a node computed by the critical path analysis
+type Task struct {
+	EarliestStart float64 // earliest time the node can start
+	LatestStart   float64 // latest time the node can start without delaying the project
+	Slack         float64 // delay allowed for the node (0 for a critical node)
+}
+
+// Schedule is the result of the critical path analysis
+type Schedule[T comparable] struct {
+	Path     []T        // longest path of the graph (critical path)
+	Duration float64    // total duration of the critical path
+	Tasks    map[T]Task // schedule of each node
+}
+
+// CriticalPath finds the longest path of a directed acyclic graph (PERT/CPM analysis)
+// * edges:    list of directed edges from one node to a list of nodes
+// * duration: give the node's duration (can be nil to give all nodes a 0 duration)
+// * weight:   give the edge's weight, as a delay between 2 nodes (can be nil to give all edges a 0 weight)
+// Returns an error if a cycle is detected
+func CriticalPath[T comparable](edges map[T][]T, duration func(T) float64, weight func(T, T) float64) (Schedule[T], error) {
+	order, err := TopologicalSort(edges)
+	if err != nil {
+		return Schedule[T]{}, err
+	}
+	if duration == nil {
+		duration = func(T) float64 { return 0 }
+	}
+	if weight == nil {
+		weight = func(T, T) float64 { return 0 }
+	}
+
+	// Forward pass: earliest start of each node
+	earliest := make(map[T]float64, len(order))
+	prev := make(map[T]T) // predecessor on the longest path
+	for _, from := range order {
+		finish := earliest[from] + duration(from)
+		for _, to := range edges[from] {
+			start := finish + weight(from, to)
+			if _, seen := prev[to]; !seen || start > earliest[to] {
+				earliest[to] = start
+				prev[to] = from
+			}
+		}
+	}
+
+	// Find the last node of the critical path (the latest in order on ties, to keep the trailing milestones)
+	var schedule Schedule[T]
+	var last T
+	for i, n := range order {
+		if finish := earliest[n] + duration(n); i == 0 || finish >= schedule.Duration {
+			schedule.Duration = finish
+			last = n
+		}
+	}
+	if len(order) > 0 {
+		schedule.Path = []T{last}
+		for n, ok := prev[last]; ok; n, ok = prev[n] {
+			schedule.Path = append(schedule.Path, n)
+		}
+		slices.Reverse(schedule.Path)
+	}
+
+	// Backward pass: latest start of each node
+	schedule.Tasks = make(map[T]Task, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		from := order[i]
+		finish := schedule.Duration
+		for _, to := range edges[from] {
+			finish = min(finish, schedule.Tasks[to].LatestStart-weight(from, to))
+		}
+		latest := finish - duration(from)
+		schedule.Tasks[from] = Task{
+			EarliestStart: earliest[from],
+			LatestStart:   latest,
+			Slack:         latest - earliest[from],
+		}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,98 @@
+package directed_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/directed"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCriticalPath(t *testing.T) {
+	a := node{id: "a"}
+	b := node{id: "b"}
+	c := node{id: "c"}
+	d := node{id: "d"}
+	e := node{id: "e"}
+
+	// a -> b, c -> d -> e
+	dg := directed.Graph[node]{
+		a: {b, c},
+		b: {d},
+		c: {d},
+		d: {e},
+	}
+	durations := map[node]float64{a: 3, b: 2, c: 4, d: 2, e: 1}
+	duration := func(n node) float64 { return durations[n] }
+
+	Convey("critical path", t, func() {
+		Convey("when no edge", func() {
+			schedule, err := directed.CriticalPath[node](nil, duration, nil)
+			So(err, ShouldBeNil)
+			So(schedule.Path, ShouldBeEmpty)
+			So(schedule.Duration, ShouldEqual, 0)
+			So(schedule.Tasks, ShouldBeEmpty)
+		})
+
+		Convey("when node durations", func() {
+			schedule, err := directed.CriticalPath(dg, duration, nil)
+			So(err, ShouldBeNil)
+			So(schedule.Path, ShouldResemble, []node{a, c, d, e})
+			So(schedule.Duration, ShouldEqual, 10)
+			So(schedule.Tasks, ShouldResemble, map[node]directed.Task{
+				a: {EarliestStart: 0, LatestStart: 0, Slack: 0},
+				b: {EarliestStart: 3, LatestStart: 5, Slack: 2},
+				c: {EarliestStart: 3, LatestStart: 3, Slack: 0},
+				d: {EarliestStart: 7, LatestStart: 7, Slack: 0},
+				e: {EarliestStart: 9, LatestStart: 9, Slack: 0},
+			})
+		})
+
+		Convey("when node durations and edge weights", func() {
+			// a -> b has a 5 delay
+			weight := func(from, to node) float64 {
+				if from == a && to == b {
+					return 5
+				}
+				return 0
+			}
+			schedule, err := directed.CriticalPath(dg, duration, weight)
+			So(err, ShouldBeNil)
+			So(schedule.Path, ShouldResemble, []node{a, b, d, e})
+			So(schedule.Duration, ShouldEqual, 13)
+			So(schedule.Tasks[c], ShouldResemble, directed.Task{EarliestStart: 3, LatestStart: 6, Slack: 3})
+		})
+
+		Convey("when edge weights only", func() {
+			weight := func(from, to node) float64 { return 1 }
+			schedule, err := directed.CriticalPath(dg, nil, weight)
+			So(err, ShouldBeNil)
+			So(schedule.Path, ShouldHaveLength, 4)
+			So(schedule.Duration, ShouldEqual, 3)
+		})
+	})
+
+	Convey("when zero-duration tasks", t, func() {
+		start := node{id: "start"}
+		schedule, err := directed.CriticalPath(directed.Graph[node]{start: {a}, a: {b}}, func(n node) float64 {
+			return map[node]float64{b: 5}[n]
+		}, nil)
+		So(err, ShouldBeNil)
+		So(schedule.Path, ShouldResemble, []node{start, a, b})
+		So(schedule.Duration, ShouldEqual, 5)
+
+		// zero-duration sink (milestone)
+		done := node{id: "done"}
+		schedule, err = directed.CriticalPath(directed.Graph[node]{start: {a}, a: {b}, b: {done}}, func(n node) float64 {
+			return map[node]float64{b: 5}[n]
+		}, nil)
+		So(err, ShouldBeNil)
+		So(schedule.Path, ShouldResemble, []node{start, a, b, done})
+		So(schedule.Duration, ShouldEqual, 5)
+	})
+
+	Convey("when error", t, func() {
+		_, err := directed.CriticalPath(directed.Graph[node]{a: {b}, b: {a}}, duration, nil)
+		So(err, ShouldBeError, directed.ErrCyclicGraph.Error())
+	})
+}
@@ -0,0 +1,136 @@
+package directed
+
+import "slices"
+
+// Dominance is the result of the dominators computation
+type Dominance[T comparable] struct {
+	IDom      map[T]T   // immediate dominator of each reachable node (except the root)
+	Tree      Graph[T]  // dominator tree: each node linked to the nodes it immediately dominates
+	Frontiers map[T][]T // dominance frontier of each node (nodes with an empty frontier are omitted)
+}
+
+// Dominators computes the dominators of each node reachable from root
+// It uses the Cooper-Harvey-Kennedy iterative algorithm
+// * edges: list of directed edges from one node to a list of nodes
+// * root:  entry node of the graph
+// Nodes not reachable from root are ignored
+func Dominators[T comparable](edges map[T][]T, root T) Dominance[T] {
+	// Number nodes in reverse postorder
+	order := reversePostorder(edges, root)
+	rank := make(map[T]int, len(order)) // position in the reverse postorder
+	for i, n := range order {
+		rank[n] = i
+	}
+
+	// Predecessors of each reachable node
+	preds := make(map[T][]T)
+	for _, from := range order {
+		for _, to := range edges[from] {
+			preds[to] = append(preds[to], from)
+		}
+	}
+
+	// Iterate until the immediate dominators are stable
+	idom := map[T]T{root: root}
+	intersect := func(a, b T) T {
+		for a != b {
+			for rank[a] > rank[b] {
+				a = idom[a]
+			}
+			for rank[b] > rank[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, n := range order[1:] {
+			var newIDom T
+			found := false
+			for _, p := range preds[n] {
+				if _, ok := idom[p]; !ok {
+					continue // not yet processed
+				}
+				if !found {
+					newIDom, found = p, true
+				} else {
+					newIDom = intersect(p, newIDom)
+				}
+			}
+			if old, ok := idom[n]; !ok || old != newIDom {
+				idom[n] = newIDom
+				changed = true
+			}
+		}
+	}
+	delete(idom, root)
+
+	// Build the dominator tree
+	tree := make(Graph[T])
+	for _, n := range order[1:] {
+		tree[idom[n]] = append(tree[idom[n]], n)
+	}
+
+	// Compute the dominance frontiers from the join nodes
+	frontiers := make(map[T][]T)
+	for _, n := range order {
+		joins := len(preds[n])
+		if n == root {
+			joins++ // implicit entry edge
+		}
+		if joins < 2 {
+			continue
+		}
+		for _, p := range preds[n] {
+			// walk up the dominator tree until the immediate dominator of n (the root has none)
+			for runner := p; n == root || runner != idom[n]; runner = idom[runner] {
+				if l := frontiers[runner]; len(l) == 0 || l[len(l)-1] != n {
+					frontiers[runner] = append(l, n)
+				}
+				if runner == root {
+					break
+				}
+			}
+		}
+	}
+
+	return Dominance[T]{IDom: idom, Tree: tree, Frontiers: frontiers}
+}
+
+// PostDominators computes the post-dominators of each node reaching exit
+// It computes the dominators of the reversed graph
+// * edges: list of directed edges from one node to a list of nodes
+// * exit:  exit node of the graph
+func PostDominators[T comparable](edges map[T][]T, exit T) Dominance[T] {
+	return Dominators(Reverse(edges), exit)
+}
+
+// reversePostorder lists the nodes reachable from root in reverse postorder (iterative depth-first search)
+func reversePostorder[T comparable](edges map[T][]T, root T) []T {
+	type frame struct {
+		node T
+		next int
+	}
+
+	var order []T
+	visited := map[T]bool{root: true}
+	stack := []frame{{node: root}}
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next < len(edges[f.node]) {
+			to := edges[f.node][f.next]
+			f.next++
+			if !visited[to] {
+				visited[to] = true
+				stack = append(stack, frame{node: to})
+			}
+			continue
+		}
+		order = append(order, f.node)
+		stack = stack[:len(stack)-1]
+	}
+
+	slices.Reverse(order)
+	return order
+}
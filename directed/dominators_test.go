@@ -0,0 +1,100 @@
+package directed_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/directed"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDominators(t *testing.T) {
+	r := node{id: "r"}
+	a := node{id: "a"}
+	b := node{id: "b"}
+	c := node{id: "c"}
+	d := node{id: "d"}
+	e := node{id: "e"}
+
+	Convey("dominators", t, func() {
+		Convey("when only root", func() {
+			dom := directed.Dominators(directed.Graph[node]{}, r)
+			So(dom.IDom, ShouldBeEmpty)
+			So(dom.Tree, ShouldBeEmpty)
+			So(dom.Frontiers, ShouldBeEmpty)
+		})
+
+		Convey("when diamond", func() {
+			// r -> a, b -> c -> d
+			// e is not reachable
+			dg := directed.Graph[node]{
+				r: {a, b},
+				a: {c},
+				b: {c},
+				c: {d},
+				e: {c},
+			}
+			dom := directed.Dominators(dg, r)
+			So(dom.IDom, ShouldResemble, map[node]node{a: r, b: r, c: r, d: c})
+			So(dom.Tree, ShouldHaveLength, 2)
+			So(dom.Tree[r], ShouldHaveLength, 3)
+			So(dom.Tree[r], ShouldContain, a)
+			So(dom.Tree[r], ShouldContain, b)
+			So(dom.Tree[r], ShouldContain, c)
+			So(dom.Tree[c], ShouldResemble, []node{d})
+			So(dom.Frontiers, ShouldResemble, map[node][]node{a: {c}, b: {c}})
+		})
+
+		Convey("when loop", func() {
+			// r -> a -> b -> c
+			//      a <- b
+			dg := directed.Graph[node]{
+				r: {a},
+				a: {b},
+				b: {a, c},
+			}
+			dom := directed.Dominators(dg, r)
+			So(dom.IDom, ShouldResemble, map[node]node{a: r, b: a, c: b})
+			So(dom.Tree, ShouldResemble, directed.Graph[node]{r: {a}, a: {b}, b: {c}})
+			So(dom.Frontiers, ShouldResemble, map[node][]node{a: {a}, b: {a}})
+		})
+
+		Convey("when loop on root", func() {
+			// r -> a -> r
+			dg := directed.Graph[node]{
+				r: {a},
+				a: {r},
+			}
+			dom := directed.Dominators(dg, r)
+			So(dom.IDom, ShouldResemble, map[node]node{a: r})
+			So(dom.Frontiers, ShouldResemble, map[node][]node{a: {r}, r: {r}})
+		})
+
+		Convey("when irreducible", func() {
+			// r -> a, b
+			// a <-> b
+			// a, b -> c
+			dg := directed.Graph[node]{
+				r: {a, b},
+				a: {b, c},
+				b: {a, c},
+			}
+			dom := directed.Dominators(dg, r)
+			So(dom.IDom, ShouldResemble, map[node]node{a: r, b: r, c: r})
+			So(dom.Frontiers, ShouldResemble, map[node][]node{a: {b, c}, b: {a, c}})
+		})
+	})
+
+	Convey("post dominators", t, func() {
+		// r -> a, b -> c -> d
+		dg := directed.Graph[node]{
+			r: {a, b},
+			a: {c},
+			b: {c},
+			c: {d},
+		}
+		dom := directed.PostDominators(dg, d)
+		So(dom.IDom, ShouldResemble, map[node]node{r: c, a: c, b: c, c: d})
+		So(dom.Frontiers, ShouldResemble, map[node][]node{a: {r}, b: {r}})
+	})
+}
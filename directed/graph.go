@@ -3,3 +3,14 @@ package directed
 // Graph represents a directed graph using an adjacency list
 // This type is an exemple, algorithms can be used without it, just using a map[T][]T
 type Graph[T comparable] map[T][]T
+
+// Reverse builds the graph with all edges reversed
+func Reverse[T comparable](edges map[T][]T) Graph[T] {
+	reversed := make(Graph[T])
+	for from, tos := range edges {
+		for _, to := range tos {
+			reversed[to] = append(reversed[to], from)
+		}
+	}
+	return reversed
+}
@@ -0,0 +1,113 @@
+package directed
+
+// Reachability is an index answering reachability queries on a directed acyclic graph
+// Each node stores the set of its descendants as a bitset
+type Reachability[T comparable] struct {
+	nodes []T       // nodes in topological order
+	index map[T]int // index of each node
+	reach []bitset  // descendants of each node
+}
+
+// NewReachability builds the reachability index of a directed acyclic graph
+// * edges: list of directed edges from one node to a list of nodes
+// Returns an error if a cycle is detected
+func NewReachability[T comparable](edges map[T][]T) (*Reachability[T], error) {
+	nodes, err := TopologicalSort(edges)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reachability[T]{
+		nodes: nodes,
+		index: make(map[T]int, len(nodes)),
+		reach: make([]bitset, len(nodes)),
+	}
+	for i, n := range nodes {
+		r.index[n] = i
+	}
+
+	// Descendants of a node are its children and their descendants (children are processed first)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		r.reach[i] = newBitset(len(nodes))
+		for _, to := range edges[nodes[i]] {
+			j := r.index[to]
+			r.reach[i].set(j)
+			r.reach[i].or(r.reach[j])
+		}
+	}
+	return r, nil
+}
+
+// CanReach checks if a path exists from one node to another (a node always reaches itself)
+func (r *Reachability[T]) CanReach(from, to T) bool {
+	if from == to {
+		return true
+	}
+	i, ok1 := r.index[from]
+	j, ok2 := r.index[to]
+	return ok1 && ok2 && r.reach[i].has(j)
+}
+
+// Reachable lists the descendants of the given node, in topological order
+func (r *Reachability[T]) Reachable(from T) []T {
+	i, ok := r.index[from]
+	if !ok {
+		return nil
+	}
+	var result []T
+	for j := i + 1; j < len(r.nodes); j++ { // descendants are always after the node
+		if r.reach[i].has(j) {
+			result = append(result, r.nodes[j])
+		}
+	}
+	return result
+}
+
+// TransitiveClosure builds the graph linking each node to all its descendants
+// * edges: list of directed edges from one node to a list of nodes
+// Returns an error if a cycle is detected
+func TransitiveClosure[T comparable](edges map[T][]T) (Graph[T], error) {
+	r, err := NewReachability(edges)
+	if err != nil {
+		return nil, err
+	}
+
+	closure := make(Graph[T])
+	for _, n := range r.nodes {
+		if reachable := r.Reachable(n); len(reachable) > 0 {
+			closure[n] = reachable
+		}
+	}
+	return closure, nil
+}
+
+// TransitiveReduction builds the smallest graph with the same reachability
+// An edge is removed if its target can be reached using another path
+// * edges: list of directed edges from one node to a list of nodes
+// Returns an error if a cycle is detected
+func TransitiveReduction[T comparable](edges map[T][]T) (Graph[T], error) {
+	r, err := NewReachability(edges)
+	if err != nil {
+		return nil, err
+	}
+
+	reduction := make(Graph[T])
+	for from, tos := range edges {
+		// Nodes reachable through a child
+		covered := newBitset(len(r.nodes))
+		for _, to := range tos {
+			covered.or(r.reach[r.index[to]])
+		}
+
+		// Keep the direct edges that cannot be deduced (and remove duplicates)
+		kept := newBitset(len(r.nodes))
+		for _, to := range tos {
+			j := r.index[to]
+			if !covered.has(j) && !kept.has(j) {
+				kept.set(j)
+				reduction[from] = append(reduction[from], to)
+			}
+		}
+	}
+	return reduction, nil
+}
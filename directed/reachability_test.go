@@ -0,0 +1,87 @@
+package directed_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/directed"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReachability(t *testing.T) {
+	a := node{id: "a"}
+	b := node{id: "b"}
+	c := node{id: "c"}
+	d := node{id: "d"}
+	e := node{id: "e"}
+	f := node{id: "f"}
+
+	// a -> b -> c -> d
+	// a -> c
+	// a -> d
+	// e -> d
+	dg := directed.Graph[node]{
+		a: {b, c, d},
+		b: {c},
+		c: {d},
+		e: {d},
+	}
+
+	Convey("reachability", t, func() {
+		r, err := directed.NewReachability(dg)
+		So(err, ShouldBeNil)
+
+		So(r.CanReach(a, d), ShouldBeTrue)
+		So(r.CanReach(b, d), ShouldBeTrue)
+		So(r.CanReach(e, d), ShouldBeTrue)
+		So(r.CanReach(a, a), ShouldBeTrue)
+		So(r.CanReach(d, a), ShouldBeFalse)
+		So(r.CanReach(a, e), ShouldBeFalse)
+		So(r.CanReach(a, f), ShouldBeFalse) // unknown node
+
+		So(r.Reachable(a), ShouldResemble, []node{b, c, d})
+		So(r.Reachable(d), ShouldBeEmpty)
+		So(r.Reachable(f), ShouldBeEmpty)
+	})
+
+	Convey("transitive closure", t, func() {
+		closure, err := directed.TransitiveClosure(dg)
+		So(err, ShouldBeNil)
+		So(closure, ShouldResemble, directed.Graph[node]{
+			a: {b, c, d},
+			b: {c, d},
+			c: {d},
+			e: {d},
+		})
+	})
+
+	Convey("transitive reduction", t, func() {
+		reduction, err := directed.TransitiveReduction(dg)
+		So(err, ShouldBeNil)
+		So(reduction, ShouldResemble, directed.Graph[node]{
+			a: {b},
+			b: {c},
+			c: {d},
+			e: {d},
+		})
+	})
+
+	Convey("when duplicated edges", t, func() {
+		reduction, err := directed.TransitiveReduction(directed.Graph[node]{a: {b, b}})
+		So(err, ShouldBeNil)
+		So(reduction, ShouldResemble, directed.Graph[node]{a: {b}})
+	})
+
+	Convey("when error", t, func() {
+		cyclic := directed.Graph[node]{a: {b}, b: {a}}
+
+		_, err := directed.NewReachability(cyclic)
+		So(err, ShouldBeError, directed.ErrCyclicGraph.Error())
+
+		_, err = directed.TransitiveClosure(cyclic)
+		So(err, ShouldBeError, directed.ErrCyclicGraph.Error())
+
+		_, err = directed.TransitiveReduction(cyclic)
+		So(err, ShouldBeError, directed.ErrCyclicGraph.Error())
+	})
+}
@@ -0,0 +1,179 @@
+package dstar
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// Planner is an incremental path planner using the D* Lite algorithm
+// The search is done backward, from the goal to the start, and its state is kept between 2 calls:
+// when the start moves or when some costs change, only the impacted nodes are updated
+type Planner[T comparable] struct {
+	start        T
+	last         T // start used to compute the keys modifier
+	goal         T
+	km           float64 // keys modifier, increased each time the start moves
+	cost         func(T, T) float64
+	distance     func(T, T) float64
+	successors   func(T) []T
+	predecessors func(T) []T
+
+	g     map[T]float64     // cost from the node to the goal
+	rhs   map[T]float64     // one-step lookahead cost from the node to the goal
+	queue *priorityQueue[T] // inconsistent nodes
+	items map[T]*item[T]    // nodes in the queue
+}
+
+// New builds an incremental planner
+// * start:        first node of the path
+// * goal:         last node of the path
+// * cost:         give the current cost of a move from a node to one of its successors (+Inf if blocked)
+// * distance:     heuristic (estimated) distance between 2 nodes (has to be consistent)
+// * successors:   list of nodes reachable from the given node
+// * predecessors: list of nodes reaching the given node (same as successors for an undirected graph)
+func New[T comparable](start, goal T, cost func(T, T) float64, distance func(T, T) float64, successors, predecessors func(T) []T) *Planner[T] {
+	p := &Planner[T]{
+		start:        start,
+		last:         start,
+		goal:         goal,
+		cost:         cost,
+		distance:     distance,
+		successors:   successors,
+		predecessors: predecessors,
+		g:            make(map[T]float64),
+		rhs:          map[T]float64{goal: 0},
+		queue:        &priorityQueue[T]{},
+		items:        make(map[T]*item[T]),
+	}
+	heap.Init(p.queue)
+	p.insert(goal)
+	return p
+}
+
+// Move sets a new start (usually, the next node of the path when the agent moves)
+func (p *Planner[T]) Move(start T) {
+	p.km += p.distance(p.last, start)
+	p.last = start
+	p.start = start
+}
+
+// Update reports that the cost of the move from one node to another has changed
+// The cost function shall already return the new cost
+func (p *Planner[T]) Update(from, _ T) {
+	p.updateNode(from)
+}
+
+// Path computes the shortest path from the current start to the goal, reusing the previous search
+// Returns the found path or nil if nothing is found
+func (p *Planner[T]) Path() []T {
+	p.computeShortestPath()
+	if math.IsInf(p.getG(p.start), 1) {
+		return nil
+	}
+
+	// Follow the best successors
+	path := []T{p.start}
+	visited := map[T]bool{p.start: true}
+	for current := p.start; current != p.goal; {
+		next, best := current, math.Inf(1)
+		for _, s := range p.successors(current) {
+			if c := p.cost(current, s) + p.getG(s); c < best {
+				next, best = s, c
+			}
+		}
+		if math.IsInf(best, 1) || visited[next] {
+			return nil
+		}
+		visited[next] = true
+		path = append(path, next)
+		current = next
+	}
+	return slices.Clip(path)
+}
+
+// Cost returns the cost of the shortest path from the current start to the goal (+Inf if no path)
+func (p *Planner[T]) Cost() float64 {
+	p.computeShortestPath()
+	return p.getG(p.start)
+}
+
+// computeShortestPath expands the inconsistent nodes until the start is consistent
+func (p *Planner[T]) computeShortestPath() {
+	for p.queue.Len() > 0 && ((*p.queue)[0].key.less(p.key(p.start)) || p.getRHS(p.start) != p.getG(p.start)) {
+		top := (*p.queue)[0]
+		u, oldKey := top.value, top.key
+		switch newKey := p.key(u); {
+		case oldKey.less(newKey):
+			// the key is outdated
+			top.key = newKey
+			heap.Fix(p.queue, top.index)
+		case p.getG(u) > p.getRHS(u):
+			// overconsistent: the node is improved
+			p.remove(u)
+			p.g[u] = p.getRHS(u)
+			for _, s := range p.predecessors(u) {
+				p.updateNode(s)
+			}
+		default:
+			// underconsistent: the node is worse
+			p.g[u] = math.Inf(1)
+			p.updateNode(u)
+			for _, s := range p.predecessors(u) {
+				p.updateNode(s)
+			}
+		}
+	}
+}
+
+// updateNode computes the one-step lookahead cost of the node and updates the queue
+func (p *Planner[T]) updateNode(u T) {
+	if u != p.goal {
+		rhs := math.Inf(1)
+		for _, s := range p.successors(u) {
+			rhs = min(rhs, p.cost(u, s)+p.getG(s))
+		}
+		p.rhs[u] = rhs
+	}
+	p.remove(u)
+	if p.getG(u) != p.getRHS(u) {
+		p.insert(u)
+	}
+}
+
+// key computes the priority of the node
+func (p *Planner[T]) key(u T) key {
+	m := min(p.getG(u), p.getRHS(u))
+	return key{m + p.distance(p.start, u) + p.km, m}
+}
+
+// insert adds the node in the queue
+func (p *Planner[T]) insert(u T) {
+	it := &item[T]{value: u, key: p.key(u)}
+	p.items[u] = it
+	heap.Push(p.queue, it)
+}
+
+// remove removes the node from the queue (if present)
+func (p *Planner[T]) remove(u T) {
+	if it, ok := p.items[u]; ok {
+		heap.Remove(p.queue, it.index)
+		delete(p.items, u)
+	}
+}
+
+// getG returns the cost from the node to the goal (+Inf if unknown)
+func (p *Planner[T]) getG(u T) float64 {
+	if g, ok := p.g[u]; ok {
+		return g
+	}
+	return math.Inf(1)
+}
+
+// getRHS returns the one-step lookahead cost from the node to the goal (+Inf if unknown)
+func (p *Planner[T]) getRHS(u T) float64 {
+	if rhs, ok := p.rhs[u]; ok {
+		return rhs
+	}
+	return math.Inf(1)
+}
@@ -0,0 +1,101 @@
+package dstar_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/dstar"
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPlanner(t *testing.T) {
+	// cells: blocked cells are not removed from the neighbors, their cost is +Inf
+	g := grid.New(6, 6)
+	g.Connectivity = grid.EightWay
+	adjacent := func(c grid.Cell) []grid.Cell {
+		var cells []grid.Cell
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				if n := (grid.Cell{X: c.X + dx, Y: c.Y + dy}); n != c && g.Inside(n) {
+					cells = append(cells, n)
+				}
+			}
+		}
+		return cells
+	}
+	cost := func(from, to grid.Cell) float64 {
+		if !g.Walkable(from) {
+			return math.Inf(1)
+		}
+		return g.MoveCost(from, to)
+	}
+
+	// block a cell and report the changed moves to the planner
+	block := func(p *dstar.Planner[grid.Cell], c grid.Cell) {
+		g.Block(c)
+		for _, n := range adjacent(c) {
+			p.Update(n, c)
+			p.Update(c, n)
+		}
+	}
+
+	// pathCost is the total cost of the moves of a path
+	pathCost := func(path []grid.Cell) float64 {
+		var total float64
+		for i := 1; i < len(path); i++ {
+			total += g.MoveCost(path[i-1], path[i])
+		}
+		return total
+	}
+
+	start := grid.Cell{X: 0, Y: 0}
+	goal := grid.Cell{X: 5, Y: 5}
+
+	Convey("D* Lite", t, func() {
+		g = grid.New(6, 6)
+		g.Connectivity = grid.EightWay
+		p := dstar.New(start, goal, cost, g.Distance, adjacent, adjacent)
+
+		Convey("when open grid", func() {
+			path := p.Path()
+			So(path, ShouldHaveLength, 6)
+			So(path[0], ShouldResemble, start)
+			So(path[5], ShouldResemble, goal)
+			So(p.Cost(), ShouldAlmostEqual, 5*math.Sqrt2)
+		})
+
+		Convey("when cells become blocked", func() {
+			So(p.Path(), ShouldHaveLength, 6)
+
+			// a wall appears
+			for y := range 5 {
+				block(p, grid.Cell{X: 3, Y: y})
+			}
+			path := p.Path()
+			So(path, ShouldContain, grid.Cell{X: 3, Y: 5})
+			So(pathCost(path), ShouldAlmostEqual, pathCost(g.Path(start, goal)))
+		})
+
+		Convey("when the start moves", func() {
+			path := p.Path()
+			p.Move(path[1])
+			block(p, grid.Cell{X: 2, Y: 2})
+			block(p, grid.Cell{X: 3, Y: 3})
+
+			path = p.Path()
+			So(path[0], ShouldResemble, grid.Cell{X: 1, Y: 1})
+			So(path[len(path)-1], ShouldResemble, goal)
+			So(pathCost(path), ShouldAlmostEqual, pathCost(g.Path(grid.Cell{X: 1, Y: 1}, goal)))
+		})
+
+		Convey("when no path", func() {
+			block(p, grid.Cell{X: 4, Y: 4})
+			block(p, grid.Cell{X: 4, Y: 5})
+			block(p, grid.Cell{X: 5, Y: 4})
+			So(p.Path(), ShouldBeNil)
+			So(p.Cost(), ShouldEqual, math.Inf(1))
+		})
+	})
+}
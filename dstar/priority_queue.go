@@ -0,0 +1,52 @@
+package dstar
+
+// key is the priority of a node, compared in lexicographic order
+type key [2]float64
+
+// less compares 2 keys
+func (k key) less(other key) bool {
+	return k[0] < other[0] || k[0] == other[0] && k[1] < other[1]
+}
+
+// item is a node in the priority queue
+type item[T comparable] struct {
+	value T   // actual value of the node
+	key   key // priority of the node
+	index int // for priority queue
+}
+
+// priorityQueue implements a priority queue for D* Lite algorithm
+type priorityQueue[T comparable] []*item[T]
+
+// Len returns the length of the priority queue
+func (q priorityQueue[T]) Len() int { return len(q) }
+
+// Less compares two items in the queue
+func (q priorityQueue[T]) Less(i, j int) bool {
+	return q[i].key.less(q[j].key)
+}
+
+// Swap swaps two items in the priority queue
+func (q priorityQueue[T]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+// Push adds an item to the priority queue
+func (q *priorityQueue[T]) Push(x any) {
+	it := x.(*item[T])
+	it.index = len(*q)
+	*q = append(*q, it)
+}
+
+// Pop removes and returns the item with the highest priority
+func (q *priorityQueue[T]) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	it.index = -1  // for safety
+	*q = old[0 : n-1]
+	return it
+}
@@ -0,0 +1,140 @@
+package flow
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sbiemont/grapo/dijkstra"
+)
+
+var (
+	ErrNegativeCapacity = fmt.Errorf("negative edge capacity")
+	ErrNegativeCost     = fmt.Errorf("negative edge cost")
+)
+
+// Edge is a directed edge of a flow network
+type Edge[T comparable] struct {
+	From     T       // tail of the edge
+	To       T       // head of the edge
+	Capacity float64 // maximum flow that can go through the edge
+	Cost     float64 // cost of one unit of flow going through the edge
+}
+
+// arc is an internal edge of the residual network
+// arcs are stored by pairs: arc 2*k is the forward arc of edge k, arc 2*k+1 is its backward arc
+type arc[T comparable] struct {
+	to       T       // head of the arc
+	capacity float64 // residual capacity
+	cost     float64 // cost of one unit of flow
+}
+
+// residual is the residual network built from the edges
+type residual[T comparable] struct {
+	arcs []arc[T]      // forward and backward arcs
+	adj  map[T][]int   // arcs indexes leaving each node
+	pot  map[T]float64 // node potentials, keeping the reduced costs non-negative
+}
+
+// newResidual builds the residual network of the given edges
+func newResidual[T comparable](edges []Edge[T]) (*residual[T], error) {
+	r := &residual[T]{
+		arcs: make([]arc[T], 0, 2*len(edges)),
+		adj:  make(map[T][]int),
+		pot:  make(map[T]float64),
+	}
+	for _, e := range edges {
+		if e.Capacity < 0 {
+			return nil, ErrNegativeCapacity
+		}
+		if e.Cost < 0 {
+			return nil, ErrNegativeCost
+		}
+		r.adj[e.From] = append(r.adj[e.From], len(r.arcs))
+		r.arcs = append(r.arcs, arc[T]{to: e.To, capacity: e.Capacity, cost: e.Cost})
+		r.adj[e.To] = append(r.adj[e.To], len(r.arcs))
+		r.arcs = append(r.arcs, arc[T]{to: e.From, capacity: 0, cost: -e.Cost})
+	}
+	return r, nil
+}
+
+// neighbors lists the nodes reachable from "from" in the residual network with their reduced cost
+// parallel arcs are merged by keeping the lowest reduced cost
+func (r *residual[T]) neighbors(from T) map[T]float64 {
+	result := make(map[T]float64)
+	for _, i := range r.adj[from] {
+		a := r.arcs[i]
+		if a.capacity <= 0 {
+			continue
+		}
+		reduced := max(a.cost+r.pot[from]-r.pot[a.to], 0) // clamp rounding errors
+		if old, ok := result[a.to]; !ok || reduced < old {
+			result[a.to] = reduced
+		}
+	}
+	return result
+}
+
+// cheapest returns the arc index from "from" to "to" with a residual capacity and the lowest cost
+func (r *residual[T]) cheapest(from, to T) int {
+	best := -1
+	for _, i := range r.adj[from] {
+		a := r.arcs[i]
+		if a.to == to && a.capacity > 0 && (best == -1 || a.cost < r.arcs[best].cost) {
+			best = i
+		}
+	}
+	return best
+}
+
+// MinCostFlow sends at most amount units of flow from source to sink at the lowest cost
+// It uses the successive shortest paths algorithm, each path is found with Dijkstra on reduced costs
+// * edges:  list of directed edges with their capacity and unit cost (both must be non-negative)
+// * source: node where the flow comes from
+// * sink:   node where the flow goes to
+// * amount: maximum flow to be sent (use math.Inf(1) for a min-cost max-flow)
+// Returns the flow actually sent, its total cost and the flow on each edge (same order as edges)
+func MinCostFlow[T comparable](edges []Edge[T], source, sink T, amount float64) (float64, float64, []float64, error) {
+	r, err := newResidual(edges)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	var flow, cost float64
+	for flow < amount && source != sink {
+		// Find the shortest path in the residual network
+		dist, prev := dijkstra.ShortestPaths(source, nil, r.neighbors)
+		if _, ok := dist[sink]; !ok {
+			break // no more augmenting path
+		}
+
+		// Update potentials of the reachable nodes
+		// unreachable nodes will never be reached again
+		for n, d := range dist {
+			r.pot[n] += d
+		}
+
+		// Collect arcs of the path and find the bottleneck capacity
+		var path []int
+		bottleneck := amount - flow
+		for n := sink; n != source; n = prev[n] {
+			i := r.cheapest(prev[n], n)
+			path = append(path, i)
+			bottleneck = math.Min(bottleneck, r.arcs[i].capacity)
+		}
+
+		// Push the flow along the path
+		for _, i := range path {
+			r.arcs[i].capacity -= bottleneck
+			r.arcs[i^1].capacity += bottleneck
+			cost += bottleneck * r.arcs[i].cost
+		}
+		flow += bottleneck
+	}
+
+	// The flow on an edge is the residual capacity of its backward arc
+	flows := make([]float64, len(edges))
+	for k := range edges {
+		flows[k] = r.arcs[2*k+1].capacity
+	}
+	return flow, cost, flows, nil
+}
@@ -0,0 +1,93 @@
+package flow_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/flow"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMinCostFlow(t *testing.T) {
+	type edge = flow.Edge[string]
+
+	Convey("min cost flow", t, func() {
+		//      (cap, cost)
+		// s --(4,2)--> a --(3,1)--> t
+		// s --(2,2)--> b --(5,3)--> t
+		// a --(2,1)--> b
+		edges := []edge{
+			{From: "s", To: "a", Capacity: 4, Cost: 2},
+			{From: "s", To: "b", Capacity: 2, Cost: 2},
+			{From: "a", To: "t", Capacity: 3, Cost: 1},
+			{From: "b", To: "t", Capacity: 5, Cost: 3},
+			{From: "a", To: "b", Capacity: 2, Cost: 1},
+		}
+
+		Convey("when the amount can be sent", func() {
+			f, cost, flows, err := flow.MinCostFlow(edges, "s", "t", 3)
+			So(err, ShouldBeNil)
+			So(f, ShouldEqual, 3)
+			So(cost, ShouldEqual, 9) // s -> a -> t (3 units)
+			So(flows, ShouldResemble, []float64{3, 0, 3, 0, 0})
+		})
+
+		Convey("when the amount is greater than the max flow", func() {
+			f, cost, flows, err := flow.MinCostFlow(edges, "s", "t", math.Inf(1))
+			So(err, ShouldBeNil)
+			So(f, ShouldEqual, 6)
+			So(cost, ShouldEqual, 9+10+6) // s -> a -> t (3), s -> b -> t (2), s -> a -> b -> t (1)
+			So(flows, ShouldResemble, []float64{4, 2, 3, 3, 1})
+		})
+
+		Convey("when the cheapest path must be undone", func() {
+			// s -> a -> b -> t is the cheapest path (cost 3)
+			// but sending 2 units requires s -> a -> t and s -> b -> t (cost 5 + 5)
+			edges := []edge{
+				{From: "s", To: "a", Capacity: 1, Cost: 1},
+				{From: "s", To: "b", Capacity: 1, Cost: 4},
+				{From: "a", To: "b", Capacity: 1, Cost: 1},
+				{From: "a", To: "t", Capacity: 1, Cost: 4},
+				{From: "b", To: "t", Capacity: 1, Cost: 1},
+			}
+			f, cost, flows, err := flow.MinCostFlow(edges, "s", "t", 2)
+			So(err, ShouldBeNil)
+			So(f, ShouldEqual, 2)
+			So(cost, ShouldEqual, 10)
+			So(flows, ShouldResemble, []float64{1, 1, 0, 1, 1})
+		})
+
+		Convey("when parallel edges", func() {
+			edges := []edge{
+				{From: "s", To: "t", Capacity: 1, Cost: 5},
+				{From: "s", To: "t", Capacity: 2, Cost: 1},
+			}
+			f, cost, flows, err := flow.MinCostFlow(edges, "s", "t", 2)
+			So(err, ShouldBeNil)
+			So(f, ShouldEqual, 2)
+			So(cost, ShouldEqual, 2)
+			So(flows, ShouldResemble, []float64{0, 2})
+		})
+
+		Convey("when no path", func() {
+			f, cost, flows, err := flow.MinCostFlow(edges, "t", "s", 1)
+			So(err, ShouldBeNil)
+			So(f, ShouldEqual, 0)
+			So(cost, ShouldEqual, 0)
+			So(flows, ShouldResemble, []float64{0, 0, 0, 0, 0})
+		})
+	})
+
+	Convey("when error", t, func() {
+		Convey("negative cost", func() {
+			_, _, _, err := flow.MinCostFlow([]flow.Edge[string]{{From: "s", To: "t", Capacity: 1, Cost: -1}}, "s", "t", 1)
+			So(err, ShouldBeError, flow.ErrNegativeCost.Error())
+		})
+
+		Convey("negative capacity", func() {
+			_, _, _, err := flow.MinCostFlow([]flow.Edge[string]{{From: "s", To: "t", Capacity: -1, Cost: 1}}, "s", "t", 1)
+			So(err, ShouldBeError, flow.ErrNegativeCapacity.Error())
+		})
+	})
+}
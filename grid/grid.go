@@ -0,0 +1,225 @@
+package grid
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrInvalidMap = fmt.Errorf("invalid map")
+)
+
+// Cell is the position of a cell in the grid
+type Cell struct {
+	X int // column
+	Y int // row
+}
+
+// Connectivity defines the allowed moves from a cell
+type Connectivity int8
+
+const (
+	FourWay  Connectivity = iota // up, down, left, right
+	EightWay                     // up, down, left, right and diagonals
+)
+
+// Corners defines when a diagonal move is allowed next to blocked cells
+type Corners int8
+
+const (
+	CutCorners      Corners = iota // diagonal moves are always allowed
+	NoSqueeze                      // diagonal moves between 2 blocked cells are forbidden
+	NoCornerCutting                // diagonal moves next to a blocked cell are forbidden
+)
+
+// Grid is a 2D grid of cells, each cell is walkable with a cost or blocked
+type Grid struct {
+	Connectivity Connectivity // allowed moves (default: FourWay)
+	Corners      Corners      // diagonal moves rule when EightWay (default: CutCorners)
+
+	width  int
+	height int
+	costs  []float64 // cost of entering each cell (+Inf for a blocked cell)
+	lowest float64   // lowest cost of a cell (for the heuristics)
+}
+
+// New builds a grid with all cells walkable with a cost of 1
+func New(width, height int) *Grid {
+	g := &Grid{
+		width:  width,
+		height: height,
+		costs:  make([]float64, width*height),
+		lowest: 1,
+	}
+	for i := range g.costs {
+		g.costs[i] = 1
+	}
+	return g
+}
+
+// Parse builds a grid from an ASCII map (one line per row)
+// * '#':      blocked cell
+// * '.':      walkable cell with a cost of 1
+// * '1'-'9':  walkable cell with the given cost
+// Empty lines at the beginning and at the end are ignored
+func Parse(s string) (*Grid, error) {
+	lines := strings.Split(strings.Trim(s, "\n"), "\n")
+	g := New(len(lines[0]), len(lines))
+	for y, line := range lines {
+		if len(line) != g.width {
+			return nil, ErrInvalidMap
+		}
+		for x, r := range line {
+			switch {
+			case r == '#':
+				g.costs[y*g.width+x] = math.Inf(1)
+			case r == '.':
+				// default cost
+			case r >= '1' && r <= '9':
+				g.costs[y*g.width+x] = float64(r - '0')
+			default:
+				return nil, ErrInvalidMap
+			}
+		}
+	}
+	g.updateLowest()
+	return g, nil
+}
+
+// Width is the number of columns
+func (g *Grid) Width() int {
+	return g.width
+}
+
+// Height is the number of rows
+func (g *Grid) Height() int {
+	return g.height
+}
+
+// Inside checks if the cell is in the grid
+func (g *Grid) Inside(c Cell) bool {
+	return c.X >= 0 && c.X < g.width && c.Y >= 0 && c.Y < g.height
+}
+
+// Walkable checks if the cell is in the grid and not blocked
+func (g *Grid) Walkable(c Cell) bool {
+	return g.Inside(c) && !math.IsInf(g.costs[c.Y*g.width+c.X], 1)
+}
+
+// Cost of entering the cell (+Inf if the cell is blocked or outside the grid)
+func (g *Grid) Cost(c Cell) float64 {
+	if !g.Inside(c) {
+		return math.Inf(1)
+	}
+	return g.costs[c.Y*g.width+c.X]
+}
+
+// SetCost sets the cost of entering the cell (+Inf to block it)
+// A cell outside the grid is ignored
+func (g *Grid) SetCost(c Cell, cost float64) {
+	if !g.Inside(c) {
+		return
+	}
+
+	i := c.Y*g.width + c.X
+	previous := g.costs[i]
+	g.costs[i] = cost
+	switch {
+	case cost < g.lowest:
+		g.lowest = cost
+	case previous == g.lowest && cost > previous:
+		g.updateLowest() // the lowest cost may have been raised
+	}
+}
+
+// updateLowest computes the lowest cost of a cell
+func (g *Grid) updateLowest() {
+	g.lowest = math.Inf(1)
+	for _, cost := range g.costs {
+		g.lowest = min(g.lowest, cost)
+	}
+}
+
+// Block sets the cell as not walkable
+// A cell outside the grid is ignored
+func (g *Grid) Block(c Cell) {
+	g.SetCost(c, math.Inf(1))
+}
+
+// Neighbors lists the walkable neighbors of the cell, according to the connectivity and corners rules
+func (g *Grid) Neighbors(c Cell) []Cell {
+	var cells []Cell
+	add := func(dx, dy int) {
+		if n := (Cell{X: c.X + dx, Y: c.Y + dy}); g.Walkable(n) {
+			cells = append(cells, n)
+		}
+	}
+
+	// up, down, left, right
+	add(0, -1)
+	add(0, 1)
+	add(-1, 0)
+	add(1, 0)
+	if g.Connectivity == FourWay {
+		return cells
+	}
+
+	// diagonal up-left, up-right, down-left, down-right
+	for _, d := range [][2]int{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+		if g.diagonal(c, d[0], d[1]) {
+			add(d[0], d[1])
+		}
+	}
+	return cells
+}
+
+// diagonal checks if a diagonal move is allowed by the corners rule
+func (g *Grid) diagonal(c Cell, dx, dy int) bool {
+	horizontal := g.Walkable(Cell{X: c.X + dx, Y: c.Y})
+	vertical := g.Walkable(Cell{X: c.X, Y: c.Y + dy})
+	switch g.Corners {
+	case NoSqueeze:
+		return horizontal || vertical
+	case NoCornerCutting:
+		return horizontal && vertical
+	default:
+		return true
+	}
+}
+
+// LineOfSight checks if all cells crossed by the straight line between the centers of 2 cells are walkable
+// When the line goes exactly through a corner, both cells around the corner have to be walkable
+func (g *Grid) LineOfSight(a, b Cell) bool {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	sx, sy := sign(dx), sign(dy)
+	dx, dy = dx*sx, dy*sy // absolute values
+
+	x, y := a.X, a.Y
+	for e, n := dx-dy, dx+dy; ; n-- {
+		if !g.Walkable(Cell{X: x, Y: y}) {
+			return false
+		}
+		if n <= 0 {
+			return true
+		}
+
+		// Move to the next crossed cell (horizontally, vertically or through a corner)
+		switch {
+		case e > 0:
+			x += sx
+			e -= 2 * dy
+		case e < 0:
+			y += sy
+			e += 2 * dx
+		default:
+			if !g.Walkable(Cell{X: x + sx, Y: y}) || !g.Walkable(Cell{X: x, Y: y + sy}) {
+				return false
+			}
+			x += sx
+			y += sy
+			e += 2 * (dx - dy)
+			n--
+		}
+	}
+}
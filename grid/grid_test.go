@@ -0,0 +1,116 @@
+package grid_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGrid(t *testing.T) {
+	Convey("parse", t, func() {
+		Convey("when ok", func() {
+			g, err := grid.Parse(`
+.#5
+9..
+`)
+			So(err, ShouldBeNil)
+			So(g.Width(), ShouldEqual, 3)
+			So(g.Height(), ShouldEqual, 2)
+			So(g.Cost(grid.Cell{X: 0, Y: 0}), ShouldEqual, 1)
+			So(g.Cost(grid.Cell{X: 1, Y: 0}), ShouldEqual, math.Inf(1))
+			So(g.Cost(grid.Cell{X: 2, Y: 0}), ShouldEqual, 5)
+			So(g.Cost(grid.Cell{X: 0, Y: 1}), ShouldEqual, 9)
+			So(g.Walkable(grid.Cell{X: 1, Y: 0}), ShouldBeFalse)
+			So(g.Walkable(grid.Cell{X: 1, Y: 1}), ShouldBeTrue)
+			So(g.Walkable(grid.Cell{X: 3, Y: 1}), ShouldBeFalse)
+		})
+
+		Convey("when lines have different lengths", func() {
+			_, err := grid.Parse("...\n..")
+			So(err, ShouldBeError, grid.ErrInvalidMap.Error())
+		})
+
+		Convey("when unknown cell", func() {
+			_, err := grid.Parse("..x")
+			So(err, ShouldBeError, grid.ErrInvalidMap.Error())
+		})
+	})
+
+	Convey("set cost", t, func() {
+		g := grid.New(3, 2)
+		g.SetCost(grid.Cell{X: 1, Y: 0}, 5)
+		g.Block(grid.Cell{X: 2, Y: 1})
+		So(g.Cost(grid.Cell{X: 1, Y: 0}), ShouldEqual, 5)
+		So(g.Walkable(grid.Cell{X: 2, Y: 1}), ShouldBeFalse)
+
+		Convey("when outside the grid", func() {
+			for _, c := range []grid.Cell{{X: 3, Y: 0}, {X: -1, Y: 1}, {X: 0, Y: 2}, {X: 0, Y: -1}} {
+				g.SetCost(c, 9)
+				g.Block(c)
+			}
+			So(g.Cost(grid.Cell{X: 0, Y: 1}), ShouldEqual, 1) // next row is unchanged
+			So(g.Cost(grid.Cell{X: 2, Y: 0}), ShouldEqual, 1) // previous row is unchanged
+		})
+	})
+
+	Convey("neighbors", t, func() {
+		// . # .
+		// . . .
+		// # . .
+		g, err := grid.Parse(".#.\n...\n#..")
+		So(err, ShouldBeNil)
+		center := grid.Cell{X: 1, Y: 1}
+		corner := grid.Cell{X: 0, Y: 0}
+
+		Convey("when four way", func() {
+			So(g.Neighbors(center), ShouldResemble, []grid.Cell{{1, 2}, {0, 1}, {2, 1}})
+			So(g.Neighbors(corner), ShouldResemble, []grid.Cell{{0, 1}})
+		})
+
+		Convey("when eight way cutting corners", func() {
+			g.Connectivity = grid.EightWay
+			So(g.Neighbors(center), ShouldResemble, []grid.Cell{{1, 2}, {0, 1}, {2, 1}, {0, 0}, {2, 0}, {2, 2}})
+			So(g.Neighbors(corner), ShouldResemble, []grid.Cell{{0, 1}, {1, 1}})
+		})
+
+		Convey("when eight way without squeezing", func() {
+			// . # .
+			// # . .
+			g, err := grid.Parse(".#.\n#..")
+			So(err, ShouldBeNil)
+			g.Connectivity = grid.EightWay
+			g.Corners = grid.NoSqueeze
+			So(g.Neighbors(grid.Cell{X: 0, Y: 0}), ShouldBeEmpty)
+			So(g.Neighbors(grid.Cell{X: 2, Y: 0}), ShouldResemble, []grid.Cell{{2, 1}, {1, 1}})
+		})
+
+		Convey("when eight way without cutting corners", func() {
+			g.Connectivity = grid.EightWay
+			g.Corners = grid.NoCornerCutting
+			So(g.Neighbors(center), ShouldResemble, []grid.Cell{{1, 2}, {0, 1}, {2, 1}, {2, 2}})
+			So(g.Neighbors(corner), ShouldResemble, []grid.Cell{{0, 1}})
+		})
+	})
+
+	Convey("line of sight", t, func() {
+		// . . . . .
+		// . . # . .
+		// . . . . .
+		g, err := grid.Parse(".....\n..#..\n.....")
+		So(err, ShouldBeNil)
+
+		So(g.LineOfSight(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 0, Y: 0}), ShouldBeTrue)
+		So(g.LineOfSight(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 4, Y: 0}), ShouldBeTrue)
+		So(g.LineOfSight(grid.Cell{X: 0, Y: 1}, grid.Cell{X: 4, Y: 1}), ShouldBeFalse)
+		So(g.LineOfSight(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 4, Y: 2}), ShouldBeFalse) // through the center
+		So(g.LineOfSight(grid.Cell{X: 1, Y: 0}, grid.Cell{X: 3, Y: 2}), ShouldBeFalse)
+		So(g.LineOfSight(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 4, Y: 1}), ShouldBeFalse)
+		So(g.LineOfSight(grid.Cell{X: 0, Y: 2}, grid.Cell{X: 4, Y: 2}), ShouldBeTrue)
+		So(g.LineOfSight(grid.Cell{X: 3, Y: 0}, grid.Cell{X: 4, Y: 1}), ShouldBeTrue)
+		So(g.LineOfSight(grid.Cell{X: 1, Y: 1}, grid.Cell{X: 2, Y: 0}), ShouldBeFalse) // corner next to a blocked cell
+		So(g.LineOfSight(grid.Cell{X: 1, Y: 0}, grid.Cell{X: 3, Y: 0}), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,345 @@
+package grid
+
+import (
+	"slices"
+
+	"github.com/sbiemont/grapo/astar"
+	"github.com/sbiemont/grapo/dijkstra"
+)
+
+// cluster is the position of a cluster in the hierarchy
+type cluster struct {
+	X, Y int
+}
+
+// border is the shared border or corner of 2 adjacent clusters (b is at the right of a, or below a)
+type border struct {
+	a, b cluster
+}
+
+// edge is a precomputed path between 2 cells of the abstract graph
+type edge struct {
+	cost float64
+	path []Cell
+}
+
+// Hierarchy splits a grid into clusters for hierarchical pathfinding (HPA*)
+// The abstract graph links the entrances of the clusters:
+// * inter-cluster edges between 2 adjacent cells on each side of a border
+// * intra-cluster edges with a precomputed path between the entrances of a cluster
+// Paths are near optimal
+type Hierarchy struct {
+	grid        *Grid
+	size        int                                // width and height of a cluster
+	transitions map[border][][2]Cell               // pairs of adjacent cells on each border
+	inter       map[Cell][]Cell                    // entrances linked to entrances of adjacent clusters
+	intra       map[cluster]map[Cell]map[Cell]edge // paths between entrances of each cluster
+}
+
+// NewHierarchy builds the abstract graph of the grid with clusters of the given size
+func NewHierarchy(g *Grid, size int) *Hierarchy {
+	h := &Hierarchy{
+		grid:        g,
+		size:        size,
+		transitions: make(map[border][][2]Cell),
+		intra:       make(map[cluster]map[Cell]map[Cell]edge),
+	}
+	// Find the entrances on the borders, then link them inside each cluster
+	h.inter = make(map[Cell][]Cell)
+	for _, k := range h.clusters() {
+		for _, b := range h.borders(k) {
+			if b.a == k {
+				h.updateBorder(b)
+			}
+		}
+	}
+	for _, k := range h.clusters() {
+		h.linkEntrances(k)
+	}
+	return h
+}
+
+// UpdateCluster updates the abstract graph after a local change of the grid
+// * c: any cell of the changed cluster
+func (h *Hierarchy) UpdateCluster(c Cell) {
+	k := h.clusterOf(c)
+	changed := []cluster{k}
+	update := func(b border) {
+		h.updateBorder(b)
+		for _, n := range []cluster{b.a, b.b} {
+			if !slices.Contains(changed, n) {
+				changed = append(changed, n)
+			}
+		}
+	}
+
+	// Update the entrances on the borders and the corners
+	for _, b := range h.borders(k) {
+		update(b)
+	}
+
+	// A diagonal move between the 2 clusters sharing a corner of k depends on the cells of k
+	for _, d := range []cluster{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+		side, vertical := cluster{k.X + d.X, k.Y}, cluster{k.X, k.Y + d.Y}
+		switch {
+		case !h.exists(side) || !h.exists(vertical):
+		case d.Y > 0:
+			update(border{side, vertical})
+		default:
+			update(border{vertical, side})
+		}
+	}
+
+	// Update the paths inside the changed clusters
+	for _, n := range changed {
+		h.linkEntrances(n)
+	}
+}
+
+// Path finds a near optimal path from start to goal using the abstract graph, then refines it
+// Returns the list of cells of the path or nil if nothing is found
+func (h *Hierarchy) Path(start, goal Cell) []Cell {
+	if !h.grid.Walkable(start) || !h.grid.Walkable(goal) {
+		return nil
+	}
+	if start == goal {
+		return []Cell{start}
+	}
+
+	// Temporary link start and goal to the entrances of their cluster
+	ks, kg := h.clusterOf(start), h.clusterOf(goal)
+	fromStart := h.localPaths(start, ks, append(h.entrances(ks), goal))
+	delete(fromStart, start)
+	toGoal := make(map[Cell]edge)
+	for _, e := range h.entrances(kg) {
+		if p, ok := h.localPaths(e, kg, []Cell{goal})[goal]; ok && e != goal {
+			toGoal[e] = p
+		}
+	}
+
+	// Find the edge between 2 cells of the abstract graph
+	find := func(a, b Cell) edge {
+		if p, ok := fromStart[b]; ok && a == start {
+			return p
+		}
+		if p, ok := toGoal[a]; ok && b == goal {
+			return p
+		}
+		if p, ok := h.intra[h.clusterOf(a)][a][b]; ok {
+			return p
+		}
+		return edge{cost: h.grid.MoveCost(a, b), path: []Cell{a, b}} // inter-cluster edge
+	}
+	neighbors := func(c Cell) []Cell {
+		var cells []Cell
+		if c == start {
+			for n := range fromStart {
+				cells = append(cells, n)
+			}
+		}
+		if _, ok := toGoal[c]; ok {
+			cells = append(cells, goal)
+		}
+		for n := range h.intra[h.clusterOf(c)][c] {
+			cells = append(cells, n)
+		}
+		return append(cells, h.inter[c]...)
+	}
+
+	abstract := astar.RunWithCost(
+		start,
+		goal,
+		func(a, b Cell) float64 { return find(a, b).cost },
+		h.grid.heuristic,
+		neighbors,
+	)
+	if abstract == nil {
+		return nil
+	}
+
+	// Refine the path using the precomputed paths
+	path := []Cell{start}
+	for i := 1; i < len(abstract); i++ {
+		path = append(path, find(abstract[i-1], abstract[i]).path[1:]...)
+	}
+	return path
+}
+
+// clusters lists all clusters of the grid
+func (h *Hierarchy) clusters() []cluster {
+	var clusters []cluster
+	for y := 0; y*h.size < h.grid.height; y++ {
+		for x := 0; x*h.size < h.grid.width; x++ {
+			clusters = append(clusters, cluster{x, y})
+		}
+	}
+	return clusters
+}
+
+// exists checks if the cluster is in the grid
+func (h *Hierarchy) exists(k cluster) bool {
+	return k.X >= 0 && k.Y >= 0 && k.X*h.size < h.grid.width && k.Y*h.size < h.grid.height
+}
+
+// borders lists the borders of the cluster with its (up to 8) adjacent clusters
+func (h *Hierarchy) borders(k cluster) []border {
+	var borders []border
+	for _, d := range []cluster{{1, 0}, {-1, 1}, {0, 1}, {1, 1}} {
+		if n := (cluster{k.X + d.X, k.Y + d.Y}); h.exists(n) {
+			borders = append(borders, border{k, n})
+		}
+		if n := (cluster{k.X - d.X, k.Y - d.Y}); h.exists(n) {
+			borders = append(borders, border{n, k})
+		}
+	}
+	return borders
+}
+
+// clusterOf returns the cluster of the cell
+func (h *Hierarchy) clusterOf(c Cell) cluster {
+	return cluster{c.X / h.size, c.Y / h.size}
+}
+
+// findTransitions finds the pairs of adjacent walkable cells on the border of 2 clusters
+// Each run of walkable pairs gives 1 transition in its middle, or 2 at its ends if long enough
+// A diagonal move gives a transition when it cannot be replaced by 2 orthogonal moves
+func (h *Hierarchy) findTransitions(a, b cluster) [][2]Cell {
+	if a.X != b.X && a.Y != b.Y {
+		// Corner: only the diagonal move between the 2 corner cells
+		x := b.X*h.size - 1
+		if b.X < a.X {
+			x = a.X * h.size
+		}
+		return h.diagonalTransitions([]Cell{{X: x, Y: b.Y*h.size - 1}}, b)
+	}
+
+	// Cells of cluster a along the border, and the offset to the cells of cluster b
+	var cells []Cell
+	var dx, dy int
+	if b.X > a.X {
+		x := b.X*h.size - 1
+		for y := a.Y * h.size; y < min((a.Y+1)*h.size, h.grid.height); y++ {
+			cells = append(cells, Cell{X: x, Y: y})
+		}
+		dx = 1
+	} else {
+		y := b.Y*h.size - 1
+		for x := a.X * h.size; x < min((a.X+1)*h.size, h.grid.width); x++ {
+			cells = append(cells, Cell{X: x, Y: y})
+		}
+		dy = 1
+	}
+
+	transitions := h.diagonalTransitions(cells, b)
+	pair := func(c Cell) [2]Cell { return [2]Cell{c, {X: c.X + dx, Y: c.Y + dy}} }
+	addRun := func(run []Cell) {
+		switch {
+		case len(run) == 0:
+		case len(run) < 6:
+			transitions = append(transitions, pair(run[len(run)/2]))
+		default:
+			transitions = append(transitions, pair(run[0]), pair(run[len(run)-1]))
+		}
+	}
+	var run []Cell
+	for _, c := range cells {
+		if p := pair(c); h.grid.Walkable(p[0]) && h.grid.Walkable(p[1]) {
+			run = append(run, c)
+		} else {
+			addRun(run)
+			run = nil
+		}
+	}
+	addRun(run)
+	return transitions
+}
+
+// diagonalTransitions finds the diagonal moves from the border cells to cluster b, with both orthogonal cells blocked
+func (h *Hierarchy) diagonalTransitions(cells []Cell, b cluster) [][2]Cell {
+	if h.grid.Connectivity == FourWay {
+		return nil
+	}
+
+	var transitions [][2]Cell
+	for _, c := range cells {
+		if !h.grid.Walkable(c) {
+			continue
+		}
+		for _, d := range [][2]int{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+			n := Cell{X: c.X + d[0], Y: c.Y + d[1]}
+			if h.clusterOf(n) != b || !h.grid.Walkable(n) || !h.grid.diagonal(c, d[0], d[1]) ||
+				h.grid.Walkable(Cell{X: n.X, Y: c.Y}) || h.grid.Walkable(Cell{X: c.X, Y: n.Y}) {
+				continue
+			}
+			transitions = append(transitions, [2]Cell{c, n})
+		}
+	}
+	return transitions
+}
+
+// updateBorder finds the transitions of the border and updates the inter-cluster edges
+func (h *Hierarchy) updateBorder(b border) {
+	for _, t := range h.transitions[b] {
+		h.inter[t[0]] = slices.DeleteFunc(h.inter[t[0]], func(c Cell) bool { return c == t[1] })
+		h.inter[t[1]] = slices.DeleteFunc(h.inter[t[1]], func(c Cell) bool { return c == t[0] })
+	}
+	h.transitions[b] = h.findTransitions(b.a, b.b)
+	for _, t := range h.transitions[b] {
+		h.inter[t[0]] = append(h.inter[t[0]], t[1])
+		h.inter[t[1]] = append(h.inter[t[1]], t[0])
+	}
+}
+
+// entrances lists the cells of the cluster linked to adjacent clusters
+func (h *Hierarchy) entrances(k cluster) []Cell {
+	var cells []Cell
+	for _, b := range h.borders(k) {
+		for _, t := range h.transitions[b] {
+			if b.a == k {
+				cells = append(cells, t[0])
+			} else {
+				cells = append(cells, t[1])
+			}
+		}
+	}
+	slices.SortFunc(cells, func(a, b Cell) int { return (a.Y-b.Y)*h.grid.width + a.X - b.X })
+	return slices.Compact(cells)
+}
+
+// linkEntrances computes the paths between all entrances of the cluster
+func (h *Hierarchy) linkEntrances(k cluster) {
+	entrances := h.entrances(k)
+	h.intra[k] = make(map[Cell]map[Cell]edge, len(entrances))
+	for _, e := range entrances {
+		h.intra[k][e] = h.localPaths(e, k, entrances)
+		delete(h.intra[k][e], e)
+	}
+}
+
+// localPaths computes the paths from a cell to the targets, staying inside the cluster
+func (h *Hierarchy) localPaths(from Cell, k cluster, targets []Cell) map[Cell]edge {
+	dist, prev := dijkstra.ShortestPaths(from, nil, func(c Cell) map[Cell]float64 {
+		neighbors := make(map[Cell]float64)
+		for _, n := range h.grid.Neighbors(c) {
+			if h.clusterOf(n) == k {
+				neighbors[n] = h.grid.MoveCost(c, n)
+			}
+		}
+		return neighbors
+	})
+
+	paths := make(map[Cell]edge)
+	for _, t := range targets {
+		cost, ok := dist[t]
+		if !ok {
+			continue
+		}
+		path := []Cell{t}
+		for c := t; c != from; c = prev[c] {
+			path = append(path, prev[c])
+		}
+		slices.Reverse(path)
+		paths[t] = edge{cost: cost, path: path}
+	}
+	return paths
+}
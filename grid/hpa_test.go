@@ -0,0 +1,130 @@
+package grid_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHierarchy(t *testing.T) {
+	Convey("hierarchical pathfinding", t, func() {
+		Convey("when same cluster", func() {
+			g := grid.New(10, 10)
+			h := grid.NewHierarchy(g, 5)
+			So(h.Path(grid.Cell{X: 1, Y: 1}, grid.Cell{X: 1, Y: 1}), ShouldResemble, []grid.Cell{{1, 1}})
+			So(h.Path(grid.Cell{X: 1, Y: 1}, grid.Cell{X: 3, Y: 1}), ShouldResemble, []grid.Cell{{1, 1}, {2, 1}, {3, 1}})
+		})
+
+		Convey("when no path", func() {
+			g, err := grid.Parse(`
+..#...
+..#...
+..#...
+`)
+			So(err, ShouldBeNil)
+			h := grid.NewHierarchy(g, 3)
+			So(h.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 5, Y: 0}), ShouldBeNil)
+			So(h.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 2, Y: 0}), ShouldBeNil) // blocked goal
+		})
+
+		Convey("when diagonal move across clusters", func() {
+			g, err := grid.Parse(`
+####
+#.##
+##.#
+####
+`)
+			So(err, ShouldBeNil)
+			g.Connectivity = grid.EightWay
+			for _, corners := range []grid.Corners{grid.CutCorners, grid.NoSqueeze, grid.NoCornerCutting} {
+				g.Corners = corners
+				h := grid.NewHierarchy(g, 2)
+				start, goal := grid.Cell{X: 1, Y: 1}, grid.Cell{X: 2, Y: 2}
+				So(h.Path(start, goal), ShouldResemble, g.Path(start, goal))
+			}
+			g.Corners = grid.CutCorners
+			So(grid.NewHierarchy(g, 2).Path(grid.Cell{X: 1, Y: 1}, grid.Cell{X: 2, Y: 2}), ShouldResemble, []grid.Cell{{1, 1}, {2, 2}})
+		})
+
+		Convey("when a cell next to a corner is updated", func() {
+			g, err := grid.Parse(`
+####
+#.##
+#..#
+####
+`)
+			So(err, ShouldBeNil)
+			g.Connectivity = grid.EightWay
+			h := grid.NewHierarchy(g, 2)
+			start, goal := grid.Cell{X: 1, Y: 1}, grid.Cell{X: 2, Y: 2}
+			So(h.Path(start, goal), ShouldNotBeNil)
+
+			// the diagonal move between the 2 other clusters becomes a transition
+			g.Block(grid.Cell{X: 1, Y: 2})
+			h.UpdateCluster(grid.Cell{X: 1, Y: 2})
+			So(h.Path(start, goal), ShouldResemble, []grid.Cell{{1, 1}, {2, 2}})
+			So(h.Path(start, goal), ShouldResemble, g.Path(start, goal))
+		})
+
+		Convey("when random grids, near optimal", func() {
+			r := rand.New(rand.NewSource(7))
+			for _, mode := range []struct {
+				connectivity grid.Connectivity
+				corners      grid.Corners
+			}{
+				{grid.FourWay, grid.NoCornerCutting},
+				{grid.EightWay, grid.NoCornerCutting},
+				{grid.EightWay, grid.NoSqueeze},
+				{grid.EightWay, grid.CutCorners},
+			} {
+				for range 25 {
+					g := randomGrid(r, 40, 40, 0.3)
+					g.Connectivity = mode.connectivity
+					g.Corners = mode.corners
+					h := grid.NewHierarchy(g, 8)
+					start := grid.Cell{X: r.Intn(40), Y: r.Intn(40)}
+					goal := grid.Cell{X: r.Intn(40), Y: r.Intn(40)}
+
+					expected := g.Path(start, goal)
+					path := h.Path(start, goal)
+					if expected == nil {
+						So(path, ShouldBeNil)
+						continue
+					}
+					So(path[0], ShouldResemble, start)
+					So(path[len(path)-1], ShouldResemble, goal)
+					So(pathLength(g, path), ShouldBeGreaterThanOrEqualTo, pathLength(g, expected)-1e-9)
+					if len(expected) >= 20 {
+						// long paths are near optimal (short ones may take a detour through the entrances)
+						So(pathLength(g, path), ShouldBeLessThanOrEqualTo, 1.3*pathLength(g, expected))
+					}
+				}
+			}
+		})
+
+		Convey("when a cluster is updated", func() {
+			g := grid.New(20, 20)
+			h := grid.NewHierarchy(g, 5)
+			start := grid.Cell{X: 0, Y: 0}
+			goal := grid.Cell{X: 19, Y: 0}
+			path := h.Path(start, goal)
+			So(pathLength(g, path), ShouldBeGreaterThan, 0)
+			So(path[len(path)-1], ShouldResemble, goal)
+
+			// a wall appears on the x=10 column, except at the bottom
+			for y := range 19 {
+				g.Block(grid.Cell{X: 10, Y: y})
+			}
+			for y := 0; y < 20; y += 5 {
+				h.UpdateCluster(grid.Cell{X: 10, Y: y})
+			}
+
+			path = h.Path(start, goal)
+			So(pathLength(g, path), ShouldBeGreaterThan, 0)
+			So(path, ShouldContain, grid.Cell{X: 10, Y: 19})
+		})
+	})
+}
@@ -0,0 +1,154 @@
+package grid
+
+import (
+	"github.com/sbiemont/grapo/astar"
+)
+
+// jumpPoint is a cell reached by a jump, with the direction of the jump
+type jumpPoint struct {
+	cell   Cell
+	dx, dy int
+}
+
+// jps stores the data required by the Jump Point Search
+type jps struct {
+	grid *Grid
+	goal Cell
+}
+
+// JumpPointPath finds the shortest path from start to goal using the Jump Point Search algorithm
+// Only the symmetric paths are pruned, the path length is the same as an A* search
+// JPS is designed for uniform-cost grids:
+// * cells costs are ignored, each move costs 1 (√2 for a diagonal move)
+// * moves are EightWay with NoCornerCutting (whatever the grid settings)
+// Returns the list of cells of the path or nil if nothing is found
+func (g *Grid) JumpPointPath(start, goal Cell) []Cell {
+	if !g.Walkable(start) || !g.Walkable(goal) {
+		return nil
+	}
+
+	j := jps{grid: g, goal: goal}
+	jumpPoints := astar.RunWithCost(
+		jumpPoint{cell: start},
+		jumpPoint{cell: goal},
+		func(a, b jumpPoint) float64 { return octile(a.cell, b.cell) },
+		func(a, b jumpPoint) float64 { return octile(a.cell, b.cell) },
+		j.successors,
+	)
+	if jumpPoints == nil {
+		return nil
+	}
+
+	// Fill the straight or diagonal lines between the jump points
+	path := []Cell{start}
+	for _, jp := range jumpPoints[1:] {
+		last := path[len(path)-1]
+		dx, dy := sign(jp.cell.X-last.X), sign(jp.cell.Y-last.Y)
+		for c := last; c != jp.cell; {
+			c = Cell{X: c.X + dx, Y: c.Y + dy}
+			path = append(path, c)
+		}
+	}
+	return path
+}
+
+// successors lists the jump points reachable from the given jump point
+func (j jps) successors(from jumpPoint) []jumpPoint {
+	var result []jumpPoint
+	for _, d := range j.directions(from) {
+		if c, ok := j.jump(from.cell, d[0], d[1]); ok {
+			if c == j.goal {
+				result = append(result, jumpPoint{cell: c}) // the goal has no direction
+			} else {
+				result = append(result, jumpPoint{cell: c, dx: d[0], dy: d[1]})
+			}
+		}
+	}
+	return result
+}
+
+// directions lists the directions to be explored from a jump point (pruned neighbors)
+func (j jps) directions(from jumpPoint) [][2]int {
+	x, y, dx, dy := from.cell.X, from.cell.Y, from.dx, from.dy
+	walkable := func(x, y int) bool { return j.grid.Walkable(Cell{X: x, Y: y}) }
+
+	var dirs [][2]int
+	switch {
+	case dx == 0 && dy == 0:
+		// start node: all directions
+		dirs = [][2]int{{0, -1}, {0, 1}, {-1, 0}, {1, 0}, {-1, -1}, {1, -1}, {-1, 1}, {1, 1}}
+	case dx != 0 && dy != 0:
+		// diagonal: natural neighbors only
+		dirs = append(dirs, [2]int{dx, 0}, [2]int{0, dy}, [2]int{dx, dy})
+	case dx != 0:
+		// horizontal: go on and turn up or down (forced neighbors)
+		dirs = append(dirs, [2]int{dx, 0})
+		for _, side := range []int{-1, 1} {
+			if walkable(x, y+side) {
+				dirs = append(dirs, [2]int{0, side}, [2]int{dx, side})
+			}
+		}
+	default:
+		// vertical: go on and turn left or right (forced neighbors)
+		dirs = append(dirs, [2]int{0, dy})
+		for _, side := range []int{-1, 1} {
+			if walkable(x+side, y) {
+				dirs = append(dirs, [2]int{side, 0}, [2]int{side, dy})
+			}
+		}
+	}
+	return dirs
+}
+
+// jump moves from the given cell in the given direction until a jump point is found
+// Returns false if an obstacle is reached first
+func (j jps) jump(from Cell, dx, dy int) (Cell, bool) {
+	walkable := func(x, y int) bool { return j.grid.Walkable(Cell{X: x, Y: y}) }
+	x, y := from.X, from.Y
+	for {
+		x, y = x+dx, y+dy
+		if !walkable(x, y) {
+			return Cell{}, false
+		}
+		diagonal := dx != 0 && dy != 0
+		if diagonal && (!walkable(x-dx, y) || !walkable(x, y-dy)) {
+			return Cell{}, false // corner cutting
+		}
+
+		c := Cell{X: x, Y: y}
+		switch {
+		case c == j.goal:
+			return c, true
+		case diagonal:
+			// a jump point can be reached horizontally or vertically
+			if _, ok := j.jump(c, dx, 0); ok {
+				return c, true
+			}
+			if _, ok := j.jump(c, 0, dy); ok {
+				return c, true
+			}
+		case dx != 0:
+			// forced neighbor up or down
+			if walkable(x, y-1) && !walkable(x-dx, y-1) || walkable(x, y+1) && !walkable(x-dx, y+1) {
+				return c, true
+			}
+		default:
+			// forced neighbor left or right
+			if walkable(x-1, y) && !walkable(x-1, y-dy) || walkable(x+1, y) && !walkable(x+1, y-dy) {
+				return c, true
+			}
+		}
+	}
+}
+
+// sign returns -1, 0 or 1
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	default:
+		return 0
+	}
+}
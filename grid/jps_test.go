@@ -0,0 +1,119 @@
+package grid_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// pathLength is the length of a path, or -1 if a move is not allowed
+func pathLength(g *grid.Grid, path []grid.Cell) float64 {
+	var length float64
+	for i := 1; i < len(path); i++ {
+		if !slices.Contains(g.Neighbors(path[i-1]), path[i]) {
+			return -1
+		}
+		length += g.MoveCost(path[i-1], path[i])
+	}
+	return length
+}
+
+// randomGrid builds a grid with randomly blocked cells
+func randomGrid(r *rand.Rand, width, height int, blocked float64) *grid.Grid {
+	g := grid.New(width, height)
+	g.Connectivity = grid.EightWay
+	g.Corners = grid.NoCornerCutting
+	for y := range height {
+		for x := range width {
+			if r.Float64() < blocked {
+				g.Block(grid.Cell{X: x, Y: y})
+			}
+		}
+	}
+	return g
+}
+
+func TestJumpPointPath(t *testing.T) {
+	Convey("jump point search", t, func() {
+		Convey("when open grid", func() {
+			g := grid.New(10, 10)
+			path := g.JumpPointPath(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 9, Y: 5})
+			So(path, ShouldHaveLength, 10)
+			So(path[0], ShouldResemble, grid.Cell{X: 0, Y: 0})
+			So(path[9], ShouldResemble, grid.Cell{X: 9, Y: 5})
+		})
+
+		Convey("when same start and goal", func() {
+			g := grid.New(3, 3)
+			So(g.JumpPointPath(grid.Cell{X: 1, Y: 1}, grid.Cell{X: 1, Y: 1}), ShouldResemble, []grid.Cell{{1, 1}})
+		})
+
+		Convey("when walls", func() {
+			g, err := grid.Parse(`
+......
+#####.
+......
+.#####
+......
+#####.
+`)
+			So(err, ShouldBeNil)
+			g.Connectivity = grid.EightWay
+			g.Corners = grid.NoCornerCutting
+
+			path := g.JumpPointPath(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 5, Y: 5})
+			So(path, ShouldResemble, []grid.Cell{
+				{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0},
+				{5, 1},
+				{5, 2}, {4, 2}, {3, 2}, {2, 2}, {1, 2}, {0, 2},
+				{0, 3},
+				{0, 4}, {1, 4}, {2, 4}, {3, 4}, {4, 4}, {5, 4},
+				{5, 5},
+			})
+		})
+
+		Convey("when no path", func() {
+			g, err := grid.Parse(".#.\n.#.")
+			So(err, ShouldBeNil)
+			So(g.JumpPointPath(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 2, Y: 0}), ShouldBeNil)
+		})
+
+		Convey("when random grids, same length as A*", func() {
+			r := rand.New(rand.NewSource(42))
+			for range 200 {
+				g := randomGrid(r, 20, 20, 0.3)
+				start := grid.Cell{X: r.Intn(20), Y: r.Intn(20)}
+				goal := grid.Cell{X: r.Intn(20), Y: r.Intn(20)}
+				expected := g.Path(start, goal)
+				path := g.JumpPointPath(start, goal)
+				if expected == nil {
+					So(path, ShouldBeNil)
+					continue
+				}
+				So(path[0], ShouldResemble, start)
+				So(path[len(path)-1], ShouldResemble, goal)
+				So(pathLength(g, path), ShouldAlmostEqual, pathLength(g, expected), 1e-9)
+			}
+		})
+	})
+}
+
+func BenchmarkAStarOpenGrid(b *testing.B) {
+	g := grid.New(1000, 1000)
+	g.Connectivity = grid.EightWay
+	g.Corners = grid.NoCornerCutting
+	for b.Loop() {
+		g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 999, Y: 500})
+	}
+}
+
+func BenchmarkJumpPointOpenGrid(b *testing.B) {
+	g := grid.New(1000, 1000)
+	for b.Loop() {
+		g.JumpPointPath(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 999, Y: 500})
+	}
+}
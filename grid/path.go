@@ -0,0 +1,47 @@
+package grid
+
+import (
+	"math"
+
+	"github.com/sbiemont/grapo/astar"
+)
+
+// MoveCost is the cost of moving between 2 adjacent cells
+// It is the cost of the entered cell, multiplied by √2 for a diagonal move
+func (g *Grid) MoveCost(from, to Cell) float64 {
+	cost := g.Cost(to)
+	if from.X != to.X && from.Y != to.Y {
+		cost *= math.Sqrt2
+	}
+	return cost
+}
+
+// Distance is the length of the shortest move between 2 cells on an open grid
+// * FourWay:  Manhattan distance
+// * EightWay: octile distance
+func (g *Grid) Distance(a, b Cell) float64 {
+	if g.Connectivity == FourWay {
+		return astar.ManhattanDistance(float64(a.X), float64(a.Y), float64(b.X), float64(b.Y))
+	}
+	return octile(a, b)
+}
+
+// octile is the length of the shortest 8-way move between 2 cells on an open grid
+func octile(a, b Cell) float64 {
+	return astar.OctileDistance(float64(a.X), float64(a.Y), float64(b.X), float64(b.Y))
+}
+
+// Path finds the cheapest path from start to goal using A*
+// Returns the list of cells of the path or nil if nothing is found
+func (g *Grid) Path(start, goal Cell) []Cell {
+	if !g.Walkable(start) || !g.Walkable(goal) {
+		return nil
+	}
+
+	return astar.RunWithCost(start, goal, g.MoveCost, g.heuristic, g.Neighbors)
+}
+
+// heuristic is the distance between 2 cells scaled by the lowest cost, to stay admissible
+func (g *Grid) heuristic(a, b Cell) float64 {
+	return g.lowest * g.Distance(a, b)
+}
@@ -0,0 +1,95 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/grid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPath(t *testing.T) {
+	// path to be found: follow the dots
+	g, err := grid.Parse(`
+......
+#####.
+......
+.#####
+......
+#####.
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := grid.Cell{X: 0, Y: 0}
+	goal := grid.Cell{X: 5, Y: 5}
+
+	Convey("when four way", t, func() {
+		path := g.Path(start, goal)
+		So(path, ShouldResemble, []grid.Cell{
+			{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0},
+			{5, 1},
+			{5, 2}, {4, 2}, {3, 2}, {2, 2}, {1, 2}, {0, 2},
+			{0, 3},
+			{0, 4}, {1, 4}, {2, 4}, {3, 4}, {4, 4}, {5, 4},
+			{5, 5},
+		})
+	})
+
+	Convey("when eight way", t, func() {
+		g.Connectivity = grid.EightWay
+		defer func() { g.Connectivity = grid.FourWay }()
+
+		path := g.Path(start, goal)
+		So(path, ShouldResemble, []grid.Cell{
+			{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0},
+			{5, 1},
+			{4, 2}, {3, 2}, {2, 2}, {1, 2},
+			{0, 3},
+			{1, 4}, {2, 4}, {3, 4}, {4, 4},
+			{5, 5},
+		})
+	})
+
+	Convey("when costs", t, func() {
+		// the expensive straight line is avoided
+		g, err := grid.Parse(`
+.9.
+...
+`)
+		So(err, ShouldBeNil)
+		path := g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 2, Y: 0})
+		So(path, ShouldResemble, []grid.Cell{{0, 0}, {0, 1}, {1, 1}, {2, 1}, {2, 0}})
+	})
+
+	Convey("when costs changed", t, func() {
+		// the heuristic follows the lowered costs
+		g, err := grid.Parse(`
+22222
+22222
+`)
+		So(err, ShouldBeNil)
+		for x := range 5 {
+			g.SetCost(grid.Cell{X: x, Y: 1}, 0.1)
+		}
+		path := g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 4, Y: 0})
+		So(path, ShouldResemble, []grid.Cell{{0, 0}, {0, 1}, {1, 1}, {2, 1}, {3, 1}, {4, 1}, {4, 0}})
+
+		// and the raised ones
+		for x := range 5 {
+			g.SetCost(grid.Cell{X: x, Y: 1}, 9)
+		}
+		path = g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 4, Y: 0})
+		So(path, ShouldResemble, []grid.Cell{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}})
+	})
+
+	Convey("when no path", t, func() {
+		g, err := grid.Parse(`
+.#.
+.#.
+`)
+		So(err, ShouldBeNil)
+		So(g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 2, Y: 0}), ShouldBeNil)
+		So(g.Path(grid.Cell{X: 0, Y: 0}, grid.Cell{X: 1, Y: 0}), ShouldBeNil) // blocked goal
+	})
+}
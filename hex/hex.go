@@ -0,0 +1,109 @@
+package hex
+
+import (
+	"math"
+
+	"github.com/sbiemont/grapo/astar"
+)
+
+// Axial is the position of a hexagon using axial coordinates
+// The third cube coordinate is s = -q - r
+type Axial struct {
+	Q int // column
+	R int // row
+}
+
+// directions of the 6 neighbors, counterclockwise starting with east (pointy top)
+var directions = [6]Axial{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}}
+
+// Add returns the sum of 2 positions
+func (a Axial) Add(b Axial) Axial {
+	return Axial{Q: a.Q + b.Q, R: a.R + b.R}
+}
+
+// Scale returns the position multiplied by k
+func (a Axial) Scale(k int) Axial {
+	return Axial{Q: a.Q * k, R: a.R * k}
+}
+
+// Neighbors lists the 6 neighbors of the hexagon
+func (a Axial) Neighbors() []Axial {
+	neighbors := make([]Axial, len(directions))
+	for i, d := range directions {
+		neighbors[i] = a.Add(d)
+	}
+	return neighbors
+}
+
+// Distance is the number of moves between 2 hexagons
+func (a Axial) Distance(b Axial) int {
+	return int(astar.HexDistance(float64(a.Q), float64(a.R), float64(b.Q), float64(b.R)))
+}
+
+// Line lists the hexagons crossed by a straight line from a to b (both included)
+func Line(a, b Axial) []Axial {
+	n := a.Distance(b)
+	if n == 0 {
+		return []Axial{a}
+	}
+
+	// Nudge the points to avoid ending exactly on an edge between 2 hexagons
+	const eps = 1e-6
+	aq, ar := float64(a.Q)+eps, float64(a.R)+eps
+	bq, br := float64(b.Q)+eps, float64(b.R)+eps
+
+	line := make([]Axial, n+1)
+	for i := range line {
+		t := float64(i) / float64(n)
+		line[i] = round(aq+(bq-aq)*t, ar+(br-ar)*t)
+	}
+	return line
+}
+
+// Ring lists the hexagons at the given distance from the center
+// Returns nil for a negative radius
+func Ring(center Axial, radius int) []Axial {
+	if radius < 0 {
+		return nil
+	}
+	if radius == 0 {
+		return []Axial{center}
+	}
+
+	ring := make([]Axial, 0, 6*radius)
+	a := center.Add(directions[4].Scale(radius))
+	for _, d := range directions {
+		for range radius {
+			ring = append(ring, a)
+			a = a.Add(d)
+		}
+	}
+	return ring
+}
+
+// Range lists the hexagons at the given distance or closer from the center
+func Range(center Axial, radius int) []Axial {
+	var hexagons []Axial
+	for q := -radius; q <= radius; q++ {
+		for r := max(-radius, -q-radius); r <= min(radius, -q+radius); r++ {
+			hexagons = append(hexagons, center.Add(Axial{Q: q, R: r}))
+		}
+	}
+	return hexagons
+}
+
+// round converts fractional axial coordinates to the nearest hexagon
+func round(q, r float64) Axial {
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+
+	// Fix the coordinate with the largest rounding error to keep q + r + s = 0
+	switch {
+	case dq > dr && dq > ds:
+		rq = -rr - rs
+	case dr > ds:
+		rr = -rq - rs
+	}
+	return Axial{Q: int(rq), R: int(rr)}
+}
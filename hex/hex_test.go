@@ -0,0 +1,73 @@
+package hex_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/hex"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHex(t *testing.T) {
+	center := hex.Axial{Q: 1, R: -2}
+
+	Convey("neighbors", t, func() {
+		neighbors := center.Neighbors()
+		So(neighbors, ShouldHaveLength, 6)
+		for _, n := range neighbors {
+			So(center.Distance(n), ShouldEqual, 1)
+		}
+	})
+
+	Convey("distance", t, func() {
+		So(center.Distance(center), ShouldEqual, 0)
+		So(hex.Axial{Q: 0, R: 0}.Distance(hex.Axial{Q: 3, R: -1}), ShouldEqual, 3)
+		So(hex.Axial{Q: -2, R: 0}.Distance(hex.Axial{Q: 2, R: 1}), ShouldEqual, 5)
+	})
+
+	Convey("line", t, func() {
+		Convey("when same hexagon", func() {
+			So(hex.Line(center, center), ShouldResemble, []hex.Axial{center})
+		})
+
+		Convey("when straight line", func() {
+			So(hex.Line(hex.Axial{Q: 0, R: 0}, hex.Axial{Q: 3, R: 0}), ShouldResemble, []hex.Axial{
+				{0, 0}, {1, 0}, {2, 0}, {3, 0},
+			})
+		})
+
+		Convey("when any line", func() {
+			a, b := hex.Axial{Q: -2, R: 0}, hex.Axial{Q: 2, R: 1}
+			line := hex.Line(a, b)
+			So(line, ShouldHaveLength, 6)
+			So(line[0], ShouldResemble, a)
+			So(line[5], ShouldResemble, b)
+			for i := 1; i < len(line); i++ {
+				So(line[i-1].Distance(line[i]), ShouldEqual, 1)
+			}
+		})
+	})
+
+	Convey("ring", t, func() {
+		So(hex.Ring(center, -1), ShouldBeNil)
+		So(hex.Ring(center, 0), ShouldResemble, []hex.Axial{center})
+		So(hex.Ring(center, 1), ShouldHaveLength, 6)
+		for _, n := range center.Neighbors() {
+			So(hex.Ring(center, 1), ShouldContain, n)
+		}
+		ring := hex.Ring(center, 3)
+		So(ring, ShouldHaveLength, 18)
+		for _, a := range ring {
+			So(center.Distance(a), ShouldEqual, 3)
+		}
+	})
+
+	Convey("range", t, func() {
+		So(hex.Range(center, 0), ShouldResemble, []hex.Axial{center})
+		hexagons := hex.Range(center, 2)
+		So(hexagons, ShouldHaveLength, 19)
+		for _, a := range hexagons {
+			So(center.Distance(a), ShouldBeLessThanOrEqualTo, 2)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package hex
+
+import (
+	"math"
+
+	"github.com/sbiemont/grapo/astar"
+	"github.com/sbiemont/grapo/directed"
+)
+
+// Map is a finite set of walkable hexagons, each one with a cost
+// Hexagons not in the map are blocked
+type Map struct {
+	costs  map[Axial]float64 // cost of entering each walkable hexagon
+	lowest float64           // lowest cost of a hexagon (for the heuristic)
+}
+
+// New builds a map with the given hexagons, all walkable with a cost of 1
+func New(hexagons []Axial) *Map {
+	m := &Map{costs: make(map[Axial]float64, len(hexagons))}
+	for _, a := range hexagons {
+		m.costs[a] = 1
+	}
+	m.updateLowest()
+	return m
+}
+
+// NewRectangle builds a rectangular map of offset coordinates, all walkable with a cost of 1
+func NewRectangle(cols, rows int, layout Layout) *Map {
+	hexagons := make([]Axial, 0, cols*rows)
+	for row := range rows {
+		for col := range cols {
+			hexagons = append(hexagons, Offset{Col: col, Row: row}.ToAxial(layout))
+		}
+	}
+	return New(hexagons)
+}
+
+// Walkable checks if the hexagon is in the map and not blocked
+func (m *Map) Walkable(a Axial) bool {
+	_, ok := m.costs[a]
+	return ok
+}
+
+// Cost of entering the hexagon (+Inf if the hexagon is blocked)
+func (m *Map) Cost(a Axial) float64 {
+	cost, ok := m.costs[a]
+	if !ok {
+		return math.Inf(1)
+	}
+	return cost
+}
+
+// SetCost sets the cost of entering the hexagon (the hexagon becomes walkable)
+func (m *Map) SetCost(a Axial, cost float64) {
+	previous, ok := m.costs[a]
+	m.costs[a] = cost
+	switch {
+	case cost < m.lowest:
+		m.lowest = cost
+	case ok && previous == m.lowest && cost > previous:
+		m.updateLowest() // the lowest cost may have been raised
+	}
+}
+
+// Block sets the hexagon as not walkable
+func (m *Map) Block(a Axial) {
+	previous, ok := m.costs[a]
+	delete(m.costs, a)
+	if ok && previous == m.lowest {
+		m.updateLowest()
+	}
+}
+
+// updateLowest computes the lowest cost of a walkable hexagon
+func (m *Map) updateLowest() {
+	m.lowest = math.Inf(1)
+	for _, cost := range m.costs {
+		m.lowest = min(m.lowest, cost)
+	}
+}
+
+// Neighbors lists the walkable neighbors of the hexagon
+func (m *Map) Neighbors(a Axial) []Axial {
+	var neighbors []Axial
+	for _, n := range a.Neighbors() {
+		if m.Walkable(n) {
+			neighbors = append(neighbors, n)
+		}
+	}
+	return neighbors
+}
+
+// MoveCost is the cost of moving between 2 adjacent hexagons (cost of the entered hexagon)
+func (m *Map) MoveCost(_, to Axial) float64 {
+	return m.Cost(to)
+}
+
+// Graph builds the directed graph of the walkable hexagons (to be used with directed.BFS, ...)
+func (m *Map) Graph() directed.Graph[Axial] {
+	g := make(directed.Graph[Axial], len(m.costs))
+	for a := range m.costs {
+		g[a] = m.Neighbors(a)
+	}
+	return g
+}
+
+// Path finds the cheapest path from start to goal using A*
+// Returns the list of hexagons of the path or nil if nothing is found
+func (m *Map) Path(start, goal Axial) []Axial {
+	if !m.Walkable(start) || !m.Walkable(goal) {
+		return nil
+	}
+
+	// The heuristic uses the lowest cost to stay admissible
+	distance := func(a, b Axial) float64 {
+		return m.lowest * float64(a.Distance(b))
+	}
+
+	return astar.RunWithCost(start, goal, m.MoveCost, distance, m.Neighbors)
+}
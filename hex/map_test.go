@@ -0,0 +1,70 @@
+package hex_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/directed"
+	"github.com/sbiemont/grapo/hex"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMap(t *testing.T) {
+	Convey("path", t, func() {
+		m := hex.New(hex.Range(hex.Axial{}, 3))
+		start := hex.Axial{Q: -2, R: 0}
+		goal := hex.Axial{Q: 2, R: 0}
+
+		Convey("when open map", func() {
+			path := m.Path(start, goal)
+			So(path, ShouldHaveLength, 5)
+			So(path[0], ShouldResemble, start)
+			So(path[4], ShouldResemble, goal)
+		})
+
+		Convey("when wall", func() {
+			// wall on the q=0 column, except on the border
+			for r := -2; r <= 2; r++ {
+				m.Block(hex.Axial{Q: 0, R: r})
+			}
+			// go around using (0, -3) or (0, 3)
+			path := m.Path(start, goal)
+			So(path, ShouldHaveLength, 9)
+			for r := -2; r <= 2; r++ {
+				So(path, ShouldNotContain, hex.Axial{Q: 0, R: r})
+			}
+		})
+
+		Convey("when costs", func() {
+			// expensive middle line
+			for _, a := range hex.Line(start, goal)[1:4] {
+				m.SetCost(a, 10)
+			}
+			path := m.Path(start, goal)
+			So(path, ShouldHaveLength, 6)
+			So(path, ShouldNotContain, hex.Axial{Q: 0, R: 0})
+		})
+
+		Convey("when no path", func() {
+			for _, a := range hex.Ring(goal, 1) {
+				m.Block(a)
+			}
+			So(m.Path(start, goal), ShouldBeNil)
+			So(m.Path(start, hex.Axial{Q: 4, R: 0}), ShouldBeNil) // outside the map
+		})
+	})
+
+	Convey("bfs", t, func() {
+		m := hex.NewRectangle(3, 2, hex.OddR)
+		m.Block(hex.Offset{Col: 1, Row: 0}.ToAxial(hex.OddR))
+
+		var visited []hex.Axial
+		err := directed.BFS(m.Graph(), hex.Axial{}, func(a hex.Axial) error {
+			visited = append(visited, a)
+			return nil
+		})
+		So(err, ShouldBeNil)
+		So(visited, ShouldHaveLength, 5)
+		So(visited, ShouldNotContain, hex.Offset{Col: 1, Row: 0}.ToAxial(hex.OddR))
+	})
+}
@@ -0,0 +1,45 @@
+package hex
+
+// Layout defines how the rows or columns of an offset grid are shifted
+type Layout int8
+
+const (
+	OddR  Layout = iota // pointy top, odd rows shifted right
+	EvenR               // pointy top, even rows shifted right
+	OddQ                // flat top, odd columns shifted down
+	EvenQ               // flat top, even columns shifted down
+)
+
+// Offset is the position of a hexagon in a rectangular grid
+type Offset struct {
+	Col int
+	Row int
+}
+
+// ToOffset converts axial coordinates to offset coordinates
+func (a Axial) ToOffset(layout Layout) Offset {
+	switch layout {
+	case EvenR:
+		return Offset{Col: a.Q + (a.R+(a.R&1))/2, Row: a.R}
+	case OddQ:
+		return Offset{Col: a.Q, Row: a.R + (a.Q-(a.Q&1))/2}
+	case EvenQ:
+		return Offset{Col: a.Q, Row: a.R + (a.Q+(a.Q&1))/2}
+	default:
+		return Offset{Col: a.Q + (a.R-(a.R&1))/2, Row: a.R}
+	}
+}
+
+// ToAxial converts offset coordinates to axial coordinates
+func (o Offset) ToAxial(layout Layout) Axial {
+	switch layout {
+	case EvenR:
+		return Axial{Q: o.Col - (o.Row+(o.Row&1))/2, R: o.Row}
+	case OddQ:
+		return Axial{Q: o.Col, R: o.Row - (o.Col-(o.Col&1))/2}
+	case EvenQ:
+		return Axial{Q: o.Col, R: o.Row - (o.Col+(o.Col&1))/2}
+	default:
+		return Axial{Q: o.Col - (o.Row-(o.Row&1))/2, R: o.Row}
+	}
+}
@@ -0,0 +1,32 @@
+package hex_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/hex"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOffset(t *testing.T) {
+	Convey("offset", t, func() {
+		Convey("when odd-r", func() {
+			So(hex.Axial{Q: 0, R: 1}.ToOffset(hex.OddR), ShouldResemble, hex.Offset{Col: 0, Row: 1})
+			So(hex.Axial{Q: -1, R: 2}.ToOffset(hex.OddR), ShouldResemble, hex.Offset{Col: 0, Row: 2})
+			So(hex.Axial{Q: -1, R: 3}.ToOffset(hex.OddR), ShouldResemble, hex.Offset{Col: 0, Row: 3})
+		})
+
+		Convey("when even-q", func() {
+			So(hex.Axial{Q: 1, R: 0}.ToOffset(hex.EvenQ), ShouldResemble, hex.Offset{Col: 1, Row: 1})
+			So(hex.Axial{Q: 2, R: -1}.ToOffset(hex.EvenQ), ShouldResemble, hex.Offset{Col: 2, Row: 0})
+		})
+
+		Convey("when round trip", func() {
+			for _, layout := range []hex.Layout{hex.OddR, hex.EvenR, hex.OddQ, hex.EvenQ} {
+				for _, a := range hex.Range(hex.Axial{}, 3) {
+					So(a.ToOffset(layout).ToAxial(layout), ShouldResemble, a)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package mapf
+
+import "container/heap"
+
+// constraint forbids a node or a move to an agent at a time step
+type constraint[T comparable] struct {
+	agent int
+	move  move[T] // forbidden move, or forbidden node if from == to
+}
+
+// ctNode is a node of the constraint tree
+type ctNode[T comparable] struct {
+	constraints []constraint[T]
+	paths       [][]T
+	cost        int // sum of costs
+}
+
+// before compares the costs, then prefers the fewest constraints
+func (n *ctNode[T]) before(other *ctNode[T]) bool {
+	return n.cost < other.cost || n.cost == other.cost && len(n.constraints) < len(other.constraints)
+}
+
+// ctQueue is a list of nodes of the constraint tree ordered by cost
+// Implement heap.Interface for ctQueue[T]
+type ctQueue[T comparable] []*ctNode[T]
+
+func (q ctQueue[T]) Len() int           { return len(q) }
+func (q ctQueue[T]) Less(i, j int) bool { return q[i].before(q[j]) }
+func (q ctQueue[T]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *ctQueue[T]) Push(x any)        { *q = append(*q, x.(*ctNode[T])) }
+
+func (q *ctQueue[T]) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*q = old[0 : n-1]
+	return x
+}
+
+// CBS performs the Conflict-Based Search to find optimal collision-free paths (minimal sum of costs)
+// The high level search resolves the conflicts by adding constraints to one of the agents,
+// the low level search plans each agent with its own constraints
+// * agents:    start and goal of each agent
+// * distance:  heuristic (estimated) number of moves between 2 nodes (has to be admissible)
+// * neighbors: list of unordered neighbors of the given node
+// * limit:     maximum number of expanded nodes of the constraint tree (0 for no limit, the search may not end if no solution exists)
+// Returns the timed path of each agent, or ErrNoPath if an agent cannot reach its goal alone,
+// or ErrNoSolution if the limit is reached
+func CBS[T comparable](agents []Agent[T], distance func(T, T) float64, neighbors func(T) []T, limit int) ([][]T, error) {
+	// Plan the agents alone, with no constraints
+	root := &ctNode[T]{paths: make([][]T, len(agents))}
+	for i, a := range agents {
+		root.paths[i] = SpaceTimeAStar(a.Start, a.Goal, distance, neighbors, nil)
+		if root.paths[i] == nil {
+			return nil, ErrNoPath
+		}
+	}
+	root.cost = SumOfCosts(root.paths)
+
+	queue := &ctQueue[T]{root}
+	for expanded := 0; queue.Len() > 0 && (limit <= 0 || expanded < limit); expanded++ {
+		current := heap.Pop(queue).(*ctNode[T])
+		c, ok := FirstConflict(current.paths)
+		if !ok {
+			return current.paths, nil
+		}
+
+		// Split the conflict: each agent in turn avoids it
+		for k, agent := range c.Agents {
+			m := move[T]{c.From, c.To, c.Time}
+			if k == 1 {
+				m = move[T]{c.To, c.From, c.Time} // opposite move or same node
+			}
+			child := &ctNode[T]{
+				constraints: append(append([]constraint[T]{}, current.constraints...), constraint[T]{agent, m}),
+				paths:       append([][]T{}, current.paths...),
+			}
+
+			// Replan the constrained agent only
+			a := agents[agent]
+			child.paths[agent] = SpaceTimeAStar(a.Start, a.Goal, distance, neighbors, child.reservations(agent))
+			if child.paths[agent] == nil {
+				continue // the agent cannot avoid the conflict
+			}
+			child.cost = SumOfCosts(child.paths)
+			heap.Push(queue, child)
+		}
+	}
+	return nil, ErrNoSolution
+}
+
+// reservations builds the reservation table of the constraints of the agent
+func (n *ctNode[T]) reservations(agent int) *Reservations[T] {
+	r := NewReservations[T]()
+	for _, c := range n.constraints {
+		switch {
+		case c.agent != agent:
+		case c.move.from == c.move.to:
+			r.ReserveNode(c.move.to, c.move.time)
+		default:
+			r.ForbidMove(c.move.from, c.move.to, c.move.time)
+		}
+	}
+	return r
+}
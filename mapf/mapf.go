@@ -0,0 +1,64 @@
+package mapf
+
+import "fmt"
+
+var (
+	ErrNoPath     = fmt.Errorf("no path found")
+	ErrNoSolution = fmt.Errorf("no solution found")
+)
+
+// Agent moves from its start to its goal
+// At each time step, an agent moves to a neighbor or waits, and it stays at its goal once arrived
+type Agent[T comparable] struct {
+	Start T
+	Goal  T
+}
+
+// Conflict between 2 agents at the same time step
+// * vertex conflict: both agents are on the same node at the given time (From == To)
+// * edge conflict:   the agents swap their nodes, moving from time-1 to time (the first agent moves From -> To)
+type Conflict[T comparable] struct {
+	Agents [2]int // indexes of the agents in conflict
+	Time   int    // time step of the conflict
+	From   T      // node left by the first agent (edge conflict)
+	To     T      // node reached by the first agent
+	Edge   bool   // true for an edge conflict
+}
+
+// at returns the node of the timed path at the given time (the last node once arrived)
+func at[T comparable](path []T, t int) T {
+	return path[min(t, len(path)-1)]
+}
+
+// FirstConflict finds the earliest conflict between the timed paths (one node per time step)
+// Returns the conflict and true, or false if the paths are collision-free
+func FirstConflict[T comparable](paths [][]T) (Conflict[T], bool) {
+	horizon := 0
+	for _, p := range paths {
+		horizon = max(horizon, len(p))
+	}
+
+	for t := 0; t < horizon; t++ {
+		for i := range paths {
+			for j := i + 1; j < len(paths); j++ {
+				a, b := at(paths[i], t), at(paths[j], t)
+				if a == b {
+					return Conflict[T]{Agents: [2]int{i, j}, Time: t, From: a, To: a}, true
+				}
+				if t > 0 && a == at(paths[j], t-1) && b == at(paths[i], t-1) {
+					return Conflict[T]{Agents: [2]int{i, j}, Time: t, From: b, To: a, Edge: true}, true
+				}
+			}
+		}
+	}
+	return Conflict[T]{}, false
+}
+
+// SumOfCosts returns the sum of the arrival times of the agents
+func SumOfCosts[T comparable](paths [][]T) int {
+	total := 0
+	for _, p := range paths {
+		total += len(p) - 1
+	}
+	return total
+}
@@ -0,0 +1,166 @@
+package mapf_test
+
+import (
+	"testing"
+
+	"github.com/sbiemont/grapo/astar"
+	"github.com/sbiemont/grapo/grid"
+	"github.com/sbiemont/grapo/mapf"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMAPF(t *testing.T) {
+	Convey("first conflict", t, func() {
+		Convey("when no conflict", func() {
+			_, ok := mapf.FirstConflict([][]int{{1, 2, 3}, {4, 5, 6}})
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("when vertex conflict", func() {
+			c, ok := mapf.FirstConflict([][]int{{1, 2, 3}, {4, 2}})
+			So(ok, ShouldBeTrue)
+			So(c, ShouldResemble, mapf.Conflict[int]{Agents: [2]int{0, 1}, Time: 1, From: 2, To: 2})
+		})
+
+		Convey("when vertex conflict with an arrived agent", func() {
+			c, ok := mapf.FirstConflict([][]int{{1, 2, 3, 4}, {5, 4}})
+			So(ok, ShouldBeTrue)
+			So(c, ShouldResemble, mapf.Conflict[int]{Agents: [2]int{0, 1}, Time: 3, From: 4, To: 4})
+		})
+
+		Convey("when edge conflict", func() {
+			c, ok := mapf.FirstConflict([][]int{{1, 2, 3}, {4, 3, 2}})
+			So(ok, ShouldBeTrue)
+			So(c, ShouldResemble, mapf.Conflict[int]{Agents: [2]int{0, 1}, Time: 2, From: 2, To: 3, Edge: true})
+		})
+	})
+
+	Convey("space-time A*", t, func() {
+		// 0 - 1 - 2 - 3
+		neighbors := func(n int) []int {
+			var res []int
+			if n > 0 {
+				res = append(res, n-1)
+			}
+			if n < 3 {
+				res = append(res, n+1)
+			}
+			return res
+		}
+		distance := func(a, b int) float64 { return float64(max(a-b, b-a)) }
+
+		Convey("when no reservation", func() {
+			So(mapf.SpaceTimeAStar(0, 3, distance, neighbors, nil), ShouldResemble, []int{0, 1, 2, 3})
+		})
+
+		Convey("when waiting", func() {
+			r := mapf.NewReservations[int]()
+			r.ReserveNode(2, 2)
+			So(mapf.SpaceTimeAStar(0, 3, distance, neighbors, r), ShouldResemble, []int{0, 1, 1, 2, 3})
+		})
+
+		Convey("when the goal is reserved later", func() {
+			r := mapf.NewReservations[int]()
+			r.ReserveNode(3, 5)
+			path := mapf.SpaceTimeAStar(0, 3, distance, neighbors, r)
+			So(path, ShouldHaveLength, 7)
+			So(path[5], ShouldNotEqual, 3)
+		})
+
+		Convey("when swap is forbidden", func() {
+			r := mapf.NewReservations[int]()
+			r.Reserve([]int{3, 2, 1})
+			So(mapf.SpaceTimeAStar(0, 3, distance, neighbors, r), ShouldBeNil) // the corridor is blocked
+		})
+
+		Convey("when following another agent", func() {
+			r := mapf.NewReservations[int]()
+			r.Reserve([]int{1, 2, 3})
+			So(mapf.SpaceTimeAStar(0, 2, distance, neighbors, r), ShouldResemble, []int{0, 1, 2})
+		})
+	})
+
+	Convey("multi-agent on a grid", t, func() {
+		graph := func(g *grid.Grid) (func(a, b grid.Cell) float64, func(c grid.Cell) []grid.Cell) {
+			distance := func(a, b grid.Cell) float64 {
+				return astar.ManhattanDistance(float64(a.X), float64(a.Y), float64(b.X), float64(b.Y))
+			}
+			return distance, g.Neighbors
+		}
+
+		Convey("when agents swap in a corridor with a pocket", func() {
+			// . . . . .
+			// # # . # #
+			g, err := grid.Parse(".....\n##.##")
+			So(err, ShouldBeNil)
+			distance, neighbors := graph(g)
+			agents := []mapf.Agent[grid.Cell]{
+				{Start: grid.Cell{X: 0, Y: 0}, Goal: grid.Cell{X: 4, Y: 0}},
+				{Start: grid.Cell{X: 4, Y: 0}, Goal: grid.Cell{X: 0, Y: 0}},
+			}
+
+			// The first agent blocks the corridor
+			_, err = mapf.Prioritized(agents, distance, neighbors)
+			So(err, ShouldBeError, mapf.ErrNoPath.Error())
+
+			// One agent waits in the pocket
+			paths, err := mapf.CBS(agents, distance, neighbors, 0)
+			So(err, ShouldBeNil)
+			_, ok := mapf.FirstConflict(paths)
+			So(ok, ShouldBeFalse)
+			So(mapf.SumOfCosts(paths), ShouldEqual, 11)
+		})
+
+		Convey("when agents cross", func() {
+			g := grid.New(5, 5)
+			distance, neighbors := graph(g)
+			agents := []mapf.Agent[grid.Cell]{
+				{Start: grid.Cell{X: 0, Y: 2}, Goal: grid.Cell{X: 4, Y: 2}},
+				{Start: grid.Cell{X: 4, Y: 2}, Goal: grid.Cell{X: 0, Y: 2}},
+				{Start: grid.Cell{X: 2, Y: 0}, Goal: grid.Cell{X: 2, Y: 4}},
+				{Start: grid.Cell{X: 2, Y: 4}, Goal: grid.Cell{X: 2, Y: 0}},
+			}
+
+			prioritized, err := mapf.Prioritized(agents, distance, neighbors)
+			So(err, ShouldBeNil)
+			_, ok := mapf.FirstConflict(prioritized)
+			So(ok, ShouldBeFalse)
+
+			optimal, err := mapf.CBS(agents, distance, neighbors, 0)
+			So(err, ShouldBeNil)
+			_, ok = mapf.FirstConflict(optimal)
+			So(ok, ShouldBeFalse)
+			for i, a := range agents {
+				So(optimal[i][0], ShouldEqual, a.Start)
+				So(optimal[i][len(optimal[i])-1], ShouldEqual, a.Goal)
+			}
+
+			// Lower bound: each agent alone
+			So(mapf.SumOfCosts(optimal), ShouldBeGreaterThanOrEqualTo, 16)
+			So(mapf.SumOfCosts(optimal), ShouldBeLessThanOrEqualTo, mapf.SumOfCosts(prioritized))
+		})
+
+		Convey("when no solution", func() {
+			// 2 agents swap in a corridor
+			g, err := grid.Parse("...")
+			So(err, ShouldBeNil)
+			distance, neighbors := graph(g)
+			agents := []mapf.Agent[grid.Cell]{
+				{Start: grid.Cell{X: 0, Y: 0}, Goal: grid.Cell{X: 2, Y: 0}},
+				{Start: grid.Cell{X: 2, Y: 0}, Goal: grid.Cell{X: 0, Y: 0}},
+			}
+			_, err = mapf.CBS(agents, distance, neighbors, 100)
+			So(err, ShouldBeError, mapf.ErrNoSolution.Error())
+		})
+
+		Convey("when an agent cannot reach its goal", func() {
+			g, err := grid.Parse(".#.")
+			So(err, ShouldBeNil)
+			distance, neighbors := graph(g)
+			agents := []mapf.Agent[grid.Cell]{{Start: grid.Cell{X: 0, Y: 0}, Goal: grid.Cell{X: 2, Y: 0}}}
+			_, err = mapf.CBS(agents, distance, neighbors, 0)
+			So(err, ShouldBeError, mapf.ErrNoPath.Error())
+		})
+	})
+}
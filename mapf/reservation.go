@@ -0,0 +1,82 @@
+package mapf
+
+// vertex is a node at a time step
+type vertex[T comparable] struct {
+	node T
+	time int
+}
+
+// move is a move from a node at time-1 to another node at time
+type move[T comparable] struct {
+	from, to T
+	time     int
+}
+
+// Reservations is a reservation table of nodes and moves in space-time
+type Reservations[T comparable] struct {
+	vertices map[vertex[T]]struct{} // reserved nodes at a time step
+	moves    map[move[T]]struct{}   // forbidden moves
+	parked   map[T]int              // nodes reserved from a time step onward
+	last     map[T]int              // last time step a node is reserved
+	horizon  int                    // last time step of all reservations
+}
+
+// NewReservations builds an empty reservation table
+func NewReservations[T comparable]() *Reservations[T] {
+	return &Reservations[T]{
+		vertices: make(map[vertex[T]]struct{}),
+		moves:    make(map[move[T]]struct{}),
+		parked:   make(map[T]int),
+		last:     make(map[T]int),
+	}
+}
+
+// Reserve reserves the nodes of a timed path (one node per time step)
+// The opposite moves are forbidden (to avoid swaps), and the last node is reserved forever
+func (r *Reservations[T]) Reserve(path []T) {
+	for t, n := range path {
+		r.ReserveNode(n, t)
+		if t > 0 && path[t-1] != n {
+			r.ForbidMove(n, path[t-1], t)
+		}
+	}
+	if len(path) > 0 {
+		r.parked[path[len(path)-1]] = len(path) - 1
+	}
+}
+
+// ReserveNode reserves the node at the given time step
+func (r *Reservations[T]) ReserveNode(n T, t int) {
+	r.vertices[vertex[T]{n, t}] = struct{}{}
+	if last, ok := r.last[n]; !ok || t > last {
+		r.last[n] = t
+	}
+	r.horizon = max(r.horizon, t)
+}
+
+// ForbidMove forbids the move from a node at time-1 to another node at time
+func (r *Reservations[T]) ForbidMove(from, to T, t int) {
+	r.moves[move[T]{from, to, t}] = struct{}{}
+	r.horizon = max(r.horizon, t)
+}
+
+// Free checks if a move from a node at time-1 to another node (or the same node to wait) at time is allowed
+func (r *Reservations[T]) Free(from, to T, t int) bool {
+	if _, ok := r.vertices[vertex[T]{to, t}]; ok {
+		return false
+	}
+	if p, ok := r.parked[to]; ok && t >= p {
+		return false
+	}
+	_, ok := r.moves[move[T]{from, to, t}]
+	return !ok
+}
+
+// final checks if an agent can stay forever on the node from the given time step
+func (r *Reservations[T]) final(n T, t int) bool {
+	if _, ok := r.parked[n]; ok {
+		return false
+	}
+	last, ok := r.last[n]
+	return !ok || last < t
+}
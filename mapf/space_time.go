@@ -0,0 +1,116 @@
+package mapf
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// state is a node at a time step, with its parent in the search
+type state[T comparable] struct {
+	vertex[T]
+	parent *state[T]
+	f      float64 // estimated number of time steps to the goal
+}
+
+// before compares the estimations, then prefers the latest time (deeper first)
+func (s *state[T]) before(other *state[T]) bool {
+	return s.f < other.f || s.f == other.f && s.time > other.time
+}
+
+// stateQueue is a list of states ordered by estimation
+// Implement heap.Interface for stateQueue[T]
+type stateQueue[T comparable] []*state[T]
+
+func (q stateQueue[T]) Len() int           { return len(q) }
+func (q stateQueue[T]) Less(i, j int) bool { return q[i].before(q[j]) }
+func (q stateQueue[T]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *stateQueue[T]) Push(x any)        { *q = append(*q, x.(*state[T])) }
+
+func (q *stateQueue[T]) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*q = old[0 : n-1]
+	return x
+}
+
+// SpaceTimeAStar performs the A* search algorithm in space-time, avoiding the reserved nodes and moves
+// Each move or wait lasts 1 time step
+// * start:        first node of the path (at time 0)
+// * goal:         last node of the path, where the agent stays forever
+// * distance:     heuristic (estimated) number of moves between 2 nodes
+// * neighbors:    list of unordered neighbors of the given node
+// * reservations: reserved nodes and moves (can be nil)
+// Returns the found timed path (one node per time step) or nil if nothing is found
+func SpaceTimeAStar[T comparable](start, goal T, distance func(T, T) float64, neighbors func(T) []T, reservations *Reservations[T]) []T {
+	if reservations == nil {
+		reservations = NewReservations[T]()
+	}
+	if !reservations.Free(start, start, 0) {
+		return nil
+	}
+
+	// After the last reservation, all time steps are equivalent
+	closed := make(map[vertex[T]]struct{})
+	equivalent := func(v vertex[T]) vertex[T] {
+		return vertex[T]{v.node, min(v.time, reservations.horizon+1)}
+	}
+
+	queue := &stateQueue[T]{}
+	push := func(s *state[T]) {
+		s.f = float64(s.time) + distance(s.node, goal)
+		heap.Push(queue, s)
+	}
+	push(&state[T]{vertex: vertex[T]{start, 0}})
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(*state[T])
+		if _, ok := closed[equivalent(current.vertex)]; ok {
+			continue // already expanded at an earlier or equivalent time
+		}
+		closed[equivalent(current.vertex)] = struct{}{}
+		if current.node == goal && reservations.final(goal, current.time) {
+			return timedPath(current)
+		}
+
+		// Move to a neighbor or wait
+		t := current.time + 1
+		for _, n := range append(neighbors(current.node), current.node) {
+			if _, ok := closed[equivalent(vertex[T]{n, t})]; ok || !reservations.Free(current.node, n, t) {
+				continue
+			}
+			push(&state[T]{vertex: vertex[T]{n, t}, parent: current})
+		}
+	}
+	return nil // no path found
+}
+
+// timedPath builds the timed path from the start to the given state
+func timedPath[T comparable](s *state[T]) []T {
+	var path []T
+	for ; s != nil; s = s.parent {
+		path = append(path, s.node)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// Prioritized plans the agents one after the other, each one avoiding the paths of the previous ones
+// Fast, but neither optimal nor complete: the order of the agents matters
+// * agents:    start and goal of each agent, by decreasing priority
+// * distance:  heuristic (estimated) number of moves between 2 nodes
+// * neighbors: list of unordered neighbors of the given node
+// Returns the timed path of each agent, or ErrNoPath if an agent cannot reach its goal
+func Prioritized[T comparable](agents []Agent[T], distance func(T, T) float64, neighbors func(T) []T) ([][]T, error) {
+	reservations := NewReservations[T]()
+	paths := make([][]T, len(agents))
+	for i, a := range agents {
+		paths[i] = SpaceTimeAStar(a.Start, a.Goal, distance, neighbors, reservations)
+		if paths[i] == nil {
+			return nil, ErrNoPath
+		}
+		reservations.Reserve(paths[i])
+	}
+	return paths, nil
+}
@@ -0,0 +1,176 @@
+package undirected
+
+// ArticulationPoints finds the nodes whose removal disconnects the graph
+// * edges: list of undirected edges from one node to a list of nodes
+// Returns the unordered list of articulation points
+func ArticulationPoints[T comparable](edges map[T][]T) []T {
+	return newTarjan(edges).run().articulations
+}
+
+// Bridges finds the edges whose removal disconnects the graph
+// * edges: list of undirected edges from one node to a list of nodes
+// Returns the unordered list of bridges
+func Bridges[T comparable](edges map[T][]T) []Edge[T] {
+	return newTarjan(edges).run().bridges
+}
+
+// BiconnectedComponents splits the graph into maximal sub-graphs without articulation point
+// An articulation point belongs to several components, isolated nodes belong to none
+// * edges: list of undirected edges from one node to a list of nodes
+// Returns the unordered list of components (each one is a list of nodes)
+func BiconnectedComponents[T comparable](edges map[T][]T) [][]T {
+	return newTarjan(edges).run().components
+}
+
+// neighbor is a node reached using an edge
+type neighbor struct {
+	node int // index of the reached node
+	edge int // index of the edge
+}
+
+// frame is a node being explored by the iterative depth-first search
+type frame struct {
+	node   int // index of the node
+	parent int // index of the edge used to reach the node (-1 for a root)
+	next   int // next neighbor to be explored
+}
+
+// tarjan stores the state of the Tarjan algorithm
+type tarjan[T comparable] struct {
+	nodes []T          // nodes by index
+	ends  [][2]int     // nodes indexes of each edge
+	adj   [][]neighbor // neighbors of each node
+	disc  []int        // discovery time of each node (0 if not yet discovered)
+	low   []int        // lowest discovery time reachable from the node sub-tree
+
+	articulations []T
+	bridges       []Edge[T]
+	components    [][]T
+}
+
+// newTarjan indexes the nodes and the edges (duplicated edges and self-loops are ignored)
+func newTarjan[T comparable](edges map[T][]T) *tarjan[T] {
+	t := &tarjan[T]{}
+	indexes := make(map[T]int)
+	index := func(n T) int {
+		i, ok := indexes[n]
+		if !ok {
+			i = len(t.nodes)
+			indexes[n] = i
+			t.nodes = append(t.nodes, n)
+			t.adj = append(t.adj, nil)
+		}
+		return i
+	}
+
+	known := make(map[[2]int]bool)
+	for from, tos := range edges {
+		i := index(from)
+		for _, to := range tos {
+			j := index(to)
+			if i == j || known[[2]int{i, j}] {
+				continue
+			}
+			known[[2]int{i, j}] = true
+			known[[2]int{j, i}] = true
+			e := len(t.ends)
+			t.ends = append(t.ends, [2]int{i, j})
+			t.adj[i] = append(t.adj[i], neighbor{node: j, edge: e})
+			t.adj[j] = append(t.adj[j], neighbor{node: i, edge: e})
+		}
+	}
+
+	t.disc = make([]int, len(t.nodes))
+	t.low = make([]int, len(t.nodes))
+	return t
+}
+
+// run performs an iterative depth-first search from each not yet discovered node
+func (t *tarjan[T]) run() *tarjan[T] {
+	time := 0
+	for root := range t.nodes {
+		if t.disc[root] != 0 {
+			continue
+		}
+		time++
+		t.disc[root], t.low[root] = time, time
+		stack := []frame{{node: root, parent: -1}}
+		var edgeStack []int
+		rootChildren := 0
+		isArticulation := make(map[int]bool)
+
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+
+			// Explore the next neighbor of the node
+			if f.next < len(t.adj[f.node]) {
+				n := t.adj[f.node][f.next]
+				f.next++
+				switch {
+				case n.edge == f.parent:
+					// do not go back using the same edge
+				case t.disc[n.node] == 0:
+					// tree edge: go deeper
+					time++
+					t.disc[n.node], t.low[n.node] = time, time
+					edgeStack = append(edgeStack, n.edge)
+					stack = append(stack, frame{node: n.node, parent: n.edge})
+				case t.disc[n.node] < t.disc[f.node]:
+					// back edge to an ancestor
+					edgeStack = append(edgeStack, n.edge)
+					t.low[f.node] = min(t.low[f.node], t.disc[n.node])
+				}
+				continue
+			}
+
+			// The node is fully explored: go back to its parent
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				break
+			}
+			child, parent := f.node, stack[len(stack)-1].node
+			t.low[parent] = min(t.low[parent], t.low[child])
+			if t.low[child] > t.disc[parent] {
+				t.bridges = append(t.bridges, Edge[T]{From: t.nodes[parent], To: t.nodes[child]})
+			}
+			if t.low[child] >= t.disc[parent] {
+				if parent == root {
+					rootChildren++
+				} else {
+					isArticulation[parent] = true
+				}
+				edgeStack = t.popComponent(edgeStack, f.parent)
+			}
+		}
+
+		if rootChildren > 1 {
+			isArticulation[root] = true
+		}
+		for i := range isArticulation {
+			t.articulations = append(t.articulations, t.nodes[i])
+		}
+	}
+	return t
+}
+
+// popComponent pops the edges of a biconnected component, up to the given edge (included)
+// Returns the remaining edges
+func (t *tarjan[T]) popComponent(edgeStack []int, last int) []int {
+	var component []T
+	seen := make(map[int]bool)
+	for {
+		e := edgeStack[len(edgeStack)-1]
+		edgeStack = edgeStack[:len(edgeStack)-1]
+		for _, i := range t.ends[e] {
+			if !seen[i] {
+				seen[i] = true
+				component = append(component, t.nodes[i])
+			}
+		}
+		if e == last {
+			break
+		}
+	}
+	t.components = append(t.components, component)
+	return edgeStack
+}
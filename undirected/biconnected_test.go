@@ -0,0 +1,90 @@
+package undirected_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sbiemont/grapo/undirected"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// sorted returns the nodes of each component sorted, and the components sorted
+func sorted(components [][]string) [][]string {
+	for _, c := range components {
+		slices.Sort(c)
+	}
+	slices.SortFunc(components, slices.Compare)
+	return components
+}
+
+func TestBiconnected(t *testing.T) {
+	Convey("biconnected", t, func() {
+		Convey("when no edge", func() {
+			So(undirected.ArticulationPoints[string](nil), ShouldBeEmpty)
+			So(undirected.Bridges[string](nil), ShouldBeEmpty)
+			So(undirected.BiconnectedComponents[string](nil), ShouldBeEmpty)
+		})
+
+		Convey("when cycle", func() {
+			// a - b - c - a
+			g := undirected.Graph[string]{
+				"a": {"b"},
+				"b": {"c"},
+				"c": {"a"},
+			}
+			So(undirected.ArticulationPoints(g), ShouldBeEmpty)
+			So(undirected.Bridges(g), ShouldBeEmpty)
+			So(sorted(undirected.BiconnectedComponents(g)), ShouldResemble, [][]string{{"a", "b", "c"}})
+		})
+
+		Convey("when 2 cycles linked by a bridge", func() {
+			// a - b - c - a
+			//         |
+			// d - e - f - d
+			//     |
+			//     g
+			g := undirected.Graph[string]{
+				"a": {"b", "c"},
+				"b": {"a", "c"},
+				"c": {"a", "b", "f"},
+				"d": {"e", "f"},
+				"e": {"d", "f", "g"},
+				"f": {"c", "d", "e"},
+			}
+
+			points := undirected.ArticulationPoints(g)
+			slices.Sort(points)
+			So(points, ShouldResemble, []string{"c", "e", "f"})
+
+			bridges := undirected.Bridges(g)
+			So(bridges, ShouldHaveLength, 2)
+			for _, b := range bridges {
+				So(b, ShouldBeIn, []undirected.Edge[string]{
+					{From: "c", To: "f"}, {From: "f", To: "c"},
+					{From: "e", To: "g"}, {From: "g", To: "e"},
+				})
+			}
+
+			So(sorted(undirected.BiconnectedComponents(g)), ShouldResemble, [][]string{
+				{"a", "b", "c"},
+				{"c", "f"},
+				{"d", "e", "f"},
+				{"e", "g"},
+			})
+		})
+
+		Convey("when large path", func() {
+			// 0 - 1 - 2 - ... - n: no stack overflow
+			n := 100000
+			g := undirected.Graph[string]{}
+			for i := range n {
+				g[fmt.Sprint(i)] = []string{fmt.Sprint(i + 1)}
+			}
+			So(undirected.ArticulationPoints(g), ShouldHaveLength, n-1)
+			So(undirected.Bridges(g), ShouldHaveLength, n)
+			So(undirected.BiconnectedComponents(g), ShouldHaveLength, n)
+		})
+	})
+}
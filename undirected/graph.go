@@ -0,0 +1,12 @@
+package undirected
+
+// Graph represents an undirected graph using an adjacency list
+// An edge can be listed from one of its nodes or from both of them
+// This type is an exemple, algorithms can be used without it, just using a map[T][]T
+type Graph[T comparable] map[T][]T
+
+// Edge is an undirected edge between 2 nodes
+type Edge[T comparable] struct {
+	From T
+	To   T
+}